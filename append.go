@@ -0,0 +1,240 @@
+package mpack
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// The Append functions pack a value onto the end of dst and return the
+// extended slice, using the same encodings as Pack.  Nothing is allocated
+// when dst has room.
+
+func AppendNil(dst []byte) []byte {
+	return append(dst, type_nil)
+}
+
+func AppendBool(dst []byte, b bool) []byte {
+	if b {
+		return append(dst, type_true)
+	}
+	return append(dst, type_false)
+}
+
+func AppendInt(dst []byte, n int64) []byte {
+	switch {
+	case n >= -32 && n <= 127:
+		return append(dst, byte(n))
+	case n >= -128 && n <= 127:
+		return append(dst, type_int8, byte(n))
+	case n >= -32768 && n <= 32767:
+		return binary.BigEndian.AppendUint16(append(dst, type_int16), uint16(n))
+	case n >= -2147483648 && n <= 2147483647:
+		return binary.BigEndian.AppendUint32(append(dst, type_int32), uint32(n))
+	}
+	return binary.BigEndian.AppendUint64(append(dst, type_int64), uint64(n))
+}
+
+func AppendUint(dst []byte, n uint64) []byte {
+	switch {
+	case n < 128:
+		return append(dst, byte(n))
+	case n < 0x100:
+		return append(dst, type_uint8, byte(n))
+	case n < 65536:
+		return binary.BigEndian.AppendUint16(append(dst, type_uint16), uint16(n))
+	case n < 4294967296:
+		return binary.BigEndian.AppendUint32(append(dst, type_uint32), uint32(n))
+	}
+	return binary.BigEndian.AppendUint64(append(dst, type_uint64), n)
+}
+
+func AppendFloat32(dst []byte, f float32) []byte {
+	return binary.BigEndian.AppendUint32(append(dst, type_float), math.Float32bits(f))
+}
+
+func AppendFloat64(dst []byte, f float64) []byte {
+	return binary.BigEndian.AppendUint64(append(dst, type_double), math.Float64bits(f))
+}
+
+// prefix followed by a length of 0, 1, 2 or 4 bytes
+func appendLength(dst []byte, code byte, length int, size int) []byte {
+	dst = append(dst, code)
+	switch size {
+	case 1:
+		return append(dst, byte(length))
+	case 2:
+		return binary.BigEndian.AppendUint16(dst, uint16(length))
+	case 4:
+		return binary.BigEndian.AppendUint32(dst, uint32(length))
+	}
+	return dst
+}
+
+func AppendString(dst []byte, s string) []byte {
+	switch {
+	case len(s) < 32:
+		dst = append(dst, type_fix_raw|uint8(len(s)))
+	case len(s) < 256:
+		dst = appendLength(dst, type_str8, len(s), 1)
+	case len(s) < 65536:
+		dst = appendLength(dst, type_raw16, len(s), 2)
+	default:
+		dst = appendLength(dst, type_raw32, len(s), 4)
+	}
+	return append(dst, s...)
+}
+
+func AppendBytes(dst []byte, b []byte) []byte {
+	switch {
+	case len(b) < 256:
+		dst = appendLength(dst, type_bin8, len(b), 1)
+	case len(b) < 65536:
+		dst = appendLength(dst, type_bin16, len(b), 2)
+	default:
+		dst = appendLength(dst, type_bin32, len(b), 4)
+	}
+	return append(dst, b...)
+}
+
+// must be followed by n elements
+func AppendArrayHeader(dst []byte, n int) []byte {
+	switch {
+	case n < 16:
+		return append(dst, type_fix_array_min|uint8(n))
+	case n < 65536:
+		return appendLength(dst, type_array16, n, 2)
+	}
+	return appendLength(dst, type_array32, n, 4)
+}
+
+// must be followed by n key/value pairs
+func AppendMapHeader(dst []byte, n int) []byte {
+	switch {
+	case n < 16:
+		return append(dst, type_fix_map_min|uint8(n))
+	case n < 65536:
+		return appendLength(dst, type_map16, n, 2)
+	}
+	return appendLength(dst, type_map32, n, 4)
+}
+
+func AppendExt(dst []byte, code int8, data []byte) []byte {
+	switch len(data) {
+	case 1:
+		dst = append(dst, type_fixext1)
+	case 2:
+		dst = append(dst, type_fixext2)
+	case 4:
+		dst = append(dst, type_fixext4)
+	case 8:
+		dst = append(dst, type_fixext8)
+	case 16:
+		dst = append(dst, type_fixext16)
+	default:
+		if len(data) < 256 {
+			dst = appendLength(dst, type_ext8, len(data), 1)
+		} else if len(data) < 65536 {
+			dst = appendLength(dst, type_ext16, len(data), 2)
+		} else {
+			dst = appendLength(dst, type_ext32, len(data), 4)
+		}
+	}
+	dst = append(dst, byte(code))
+	return append(dst, data...)
+}
+
+// an io.Writer that appends to a slice
+type appendWriter struct {
+	buf []byte
+}
+
+func (w *appendWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	return len(p), nil
+}
+
+// AppendValue packs any value Pack can.  Basic types, []interface{} and
+// map[string]interface{} are appended directly; everything else goes
+// through a PackWriter.
+func AppendValue(dst []byte, value interface{}) ([]byte, error) {
+	return appendValue(dst, value, 0)
+}
+
+// containers nested deeper than this are left to a PackWriter, which
+// checks for cycles
+const appendDirectDepth = 100
+
+func appendValue(dst []byte, value interface{}, depth int) ([]byte, error) {
+	switch tvalue := value.(type) {
+	case nil:
+		return AppendNil(dst), nil
+	case bool:
+		return AppendBool(dst, tvalue), nil
+	case int:
+		return AppendInt(dst, int64(tvalue)), nil
+	case int8:
+		return AppendInt(dst, int64(tvalue)), nil
+	case int16:
+		return AppendInt(dst, int64(tvalue)), nil
+	case int32:
+		return AppendInt(dst, int64(tvalue)), nil
+	case int64:
+		return AppendInt(dst, tvalue), nil
+	case uint:
+		return AppendUint(dst, uint64(tvalue)), nil
+	case uint8:
+		return AppendUint(dst, uint64(tvalue)), nil
+	case uint16:
+		return AppendUint(dst, uint64(tvalue)), nil
+	case uint32:
+		return AppendUint(dst, uint64(tvalue)), nil
+	case uint64:
+		return AppendUint(dst, tvalue), nil
+	case float32:
+		return AppendFloat32(dst, tvalue), nil
+	case float64:
+		return AppendFloat64(dst, tvalue), nil
+	case string:
+		return AppendString(dst, tvalue), nil
+	case []byte:
+		return AppendBytes(dst, tvalue), nil
+	case []int64:
+		dst = AppendArrayHeader(dst, len(tvalue))
+		for _, n := range tvalue {
+			dst = AppendInt(dst, n)
+		}
+		return dst, nil
+	case []interface{}:
+		if depth >= appendDirectDepth {
+			break
+		}
+		dst = AppendArrayHeader(dst, len(tvalue))
+		for i, elt := range tvalue {
+			var err error
+			dst, err = appendValue(dst, elt, depth+1)
+			if err != nil {
+				return dst, addPath(err, fmt.Sprintf("[%d]", i))
+			}
+		}
+		return dst, nil
+	case map[string]interface{}:
+		if depth >= appendDirectDepth {
+			break
+		}
+		dst = AppendMapHeader(dst, len(tvalue))
+		for k, v := range tvalue {
+			dst = AppendString(dst, k)
+			var err error
+			dst, err = appendValue(dst, v, depth+1)
+			if err != nil {
+				return dst, addPath(err, keyPath(k))
+			}
+		}
+		return dst, nil
+	}
+
+	w := &appendWriter{buf: dst}
+	_, err := NewPackWriter(w).Encode(value)
+	return w.buf, err
+}
@@ -3,6 +3,7 @@ package mpack
 import (
 	"bytes"
 	"reflect"
+	"time"
 )
 
 type Array struct {
@@ -67,10 +68,27 @@ func (a Array) Uint32Item(index int) uint32 {
 }
 
 func (a Array) StringItem(index int) string {
+	if a.raw[index] == nil {
+		return ""
+	}
+	if s, ok := a.raw[index].(string); ok {
+		return s
+	}
 	return string(a.raw[index].([]uint8))
 }
 
+func (a Array) TimeItem(index int) time.Time {
+	t, _ := a.raw[index].(time.Time)
+	return t
+}
+
 func (a Array) BufferItem(index int) *bytes.Buffer {
+	if a.raw[index] == nil {
+		return new(bytes.Buffer)
+	}
+	if s, ok := a.raw[index].(string); ok {
+		return bytes.NewBufferString(s)
+	}
 	return bytes.NewBuffer(a.raw[index].([]byte))
 }
 
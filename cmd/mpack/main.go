@@ -0,0 +1,287 @@
+// mpack converts between JSON and msgpack.
+//
+//	mpack encode < in.json > out.msgpack
+//	mpack decode [-bin base64|hex|utf8] [-keys string|json|error] [-indent] < in.msgpack
+//	mpack inspect < in.msgpack
+//
+// encode packs each JSON value read from stdin.  decode unpacks every
+// value in a stream of concatenated msgpack values and writes each one as
+// a line of JSON.  JSON has no binary type or non-string object keys, so
+// -bin says how bin values are written and -keys how map keys that aren't
+// strings are: as their fmt representation, as their JSON encoding, or
+// not at all.  Ext values without a registered decoder are written as
+// {"ext": type, "data": bin}.  inspect writes an annotated dump of the
+// stream, marking where it stops being valid msgpack.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"mpack"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const usage = `usage:
+	mpack encode < in.json > out.msgpack
+	mpack decode [flags] < in.msgpack
+	mpack inspect < in.msgpack
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "encode":
+		fs := flag.NewFlagSet("encode", flag.ExitOnError)
+		fs.Parse(os.Args[2:])
+		err = encode(os.Stdin, os.Stdout)
+	case "decode":
+		var opts decodeOptions
+		fs := flag.NewFlagSet("decode", flag.ExitOnError)
+		fs.StringVar(&opts.bin, "bin", "base64", "how to write bin values: base64, hex or utf8")
+		fs.StringVar(&opts.keys, "keys", "string", "how to write non-string map keys: string, json or error")
+		fs.BoolVar(&opts.indent, "indent", false, "indent the JSON output")
+		fs.Parse(os.Args[2:])
+		err = decode(os.Stdin, os.Stdout, opts)
+	case "inspect":
+		fs := flag.NewFlagSet("inspect", flag.ExitOnError)
+		fs.Parse(os.Args[2:])
+		bw := bufio.NewWriter(os.Stdout)
+		err = mpack.Inspect(bufio.NewReader(os.Stdin), bw)
+		bw.Flush()
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		// errors from the mpack package already say where they are from
+		msg := err.Error()
+		if !strings.HasPrefix(msg, "mpack: ") {
+			msg = "mpack: " + msg
+		}
+		fmt.Fprintln(os.Stderr, msg)
+		os.Exit(1)
+	}
+}
+
+// pack every JSON value in r
+func encode(r io.Reader, w io.Writer) error {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	bw := bufio.NewWriter(w)
+	for {
+		var v interface{}
+		err := dec.Decode(&v)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		_, err = mpack.Pack(bw, fromJSON(v))
+		if err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// numbers are packed as integers when they are integers
+func fromJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n
+		}
+		if u, err := strconv.ParseUint(string(v), 10, 64); err == nil {
+			return u
+		}
+		f, _ := v.Float64()
+		return f
+	case []interface{}:
+		for i, elt := range v {
+			v[i] = fromJSON(elt)
+		}
+		return v
+	case map[string]interface{}:
+		for k, elt := range v {
+			v[k] = fromJSON(elt)
+		}
+		return v
+	}
+	return v
+}
+
+type decodeOptions struct {
+	bin    string
+	keys   string
+	indent bool
+}
+
+// write every value in the stream r as a line of JSON
+func decode(r io.Reader, w io.Writer, opts decodeOptions) error {
+	switch opts.bin {
+	case "base64", "hex", "utf8":
+	default:
+		return fmt.Errorf("unknown -bin %q", opts.bin)
+	}
+	switch opts.keys {
+	case "string", "json", "error":
+	default:
+		return fmt.Errorf("unknown -keys %q", opts.keys)
+	}
+
+	pr := mpack.NewPackReader(bufio.NewReader(r))
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	enc.SetEscapeHTML(false)
+	if opts.indent {
+		enc.SetIndent("", "  ")
+	}
+	for {
+		j, err := opts.read(pr)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			bw.Flush()
+			return err
+		}
+		err = enc.Encode(j)
+		if err != nil {
+			bw.Flush()
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// read the next value as something encoding/json can write.  maps are
+// read a token at a time rather than unpacked, so that keys a Go map
+// can't hold, like arrays, can still be written the way -keys says.
+func (opts decodeOptions) read(pr *mpack.PackReader) (interface{}, error) {
+	tok, err := pr.NextToken()
+	if err != nil {
+		return nil, err
+	}
+	return opts.value(pr, tok)
+}
+
+// the value tok starts, with the elements of arrays and maps read from pr
+func (opts decodeOptions) value(pr *mpack.PackReader, tok mpack.Token) (interface{}, error) {
+	switch tok.Kind {
+	case mpack.ArrayToken:
+		result := make([]interface{}, tok.Len)
+		for i := range result {
+			elt, err := opts.read(pr)
+			if err != nil {
+				return nil, unexpectedEOF(err)
+			}
+			result[i] = elt
+		}
+		return result, nil
+	case mpack.MapToken:
+		result := make(map[string]interface{}, tok.Len)
+		for i := 0; i < tok.Len; i++ {
+			key, err := opts.readKey(pr)
+			if err != nil {
+				return nil, unexpectedEOF(err)
+			}
+			elt, err := opts.read(pr)
+			if err != nil {
+				return nil, unexpectedEOF(err)
+			}
+			result[key] = elt
+		}
+		return result, nil
+	}
+	return opts.toJSON(tok.Value)
+}
+
+// scalar keys are passed to key as they were unpacked, and arrays and
+// maps once they have been converted for JSON
+func (opts decodeOptions) readKey(pr *mpack.PackReader) (string, error) {
+	tok, err := pr.NextToken()
+	if err != nil {
+		return "", err
+	}
+	if tok.Kind != mpack.ArrayToken && tok.Kind != mpack.MapToken {
+		return opts.key(tok.Value)
+	}
+	k, err := opts.value(pr, tok)
+	if err != nil {
+		return "", err
+	}
+	return opts.key(k)
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// scalars JSON can't represent as they are
+func (opts decodeOptions) toJSON(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case []byte:
+		return opts.binary(v), nil
+	case float32:
+		return nonFinite(float64(v)), nil
+	case float64:
+		return nonFinite(v), nil
+	case mpack.Ext:
+		return map[string]interface{}{"ext": v.Type, "data": opts.binary(v.Data)}, nil
+	}
+	return v, nil
+}
+
+func (opts decodeOptions) binary(b []byte) string {
+	switch opts.bin {
+	case "hex":
+		return hex.EncodeToString(b)
+	case "utf8":
+		return string(bytes.ToValidUTF8(b, []byte("�")))
+	}
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+func (opts decodeOptions) key(k interface{}) (string, error) {
+	if s, ok := k.(string); ok {
+		return s, nil
+	}
+	switch opts.keys {
+	case "json":
+		j, err := opts.toJSON(k)
+		if err != nil {
+			return "", err
+		}
+		b, err := json.Marshal(j)
+		return string(b), err
+	case "error":
+		return "", fmt.Errorf("map key %v is not a string", k)
+	}
+	return fmt.Sprint(k), nil
+}
+
+// JSON has no NaN or infinities, so they are written as strings
+func nonFinite(f float64) interface{} {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return fmt.Sprint(f)
+	}
+	return f
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bytes"
+	"mpack"
+	"strings"
+	"testing"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	in := `{"a": [1, -2, 2.5, 18446744073709551615], "b": null} "two" true`
+	packed := new(bytes.Buffer)
+	err := encode(strings.NewReader(in), packed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := new(bytes.Buffer)
+	err = decode(packed, out, decodeOptions{bin: "base64", keys: "string"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"a":[1,-2,2.5,18446744073709551615],"b":null}` + "\n" + `"two"` + "\n" + "true\n"
+	if out.String() != expected {
+		t.Errorf("expected %q, got %q", expected, out.String())
+	}
+}
+
+func TestDecodeOptions(t *testing.T) {
+	packed := new(bytes.Buffer)
+	mpack.Pack(packed, map[interface{}]interface{}{1: []byte("hi"), "e": mpack.Ext{Type: 7, Data: []byte{0xff}}})
+
+	cases := []struct {
+		opts     decodeOptions
+		expected string
+	}{
+		{decodeOptions{bin: "base64", keys: "string"}, `{"1":"aGk=","e":{"data":"/w==","ext":7}}`},
+		{decodeOptions{bin: "hex", keys: "json"}, `{"1":"6869","e":{"data":"ff","ext":7}}`},
+		{decodeOptions{bin: "utf8", keys: "string"}, `{"1":"hi","e":{"data":"�","ext":7}}`},
+	}
+	for _, c := range cases {
+		out := new(bytes.Buffer)
+		err := decode(bytes.NewReader(packed.Bytes()), out, c.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out.String() != c.expected+"\n" {
+			t.Errorf("%+v: expected %s, got %s", c.opts, c.expected, out.String())
+		}
+	}
+
+	err := decode(bytes.NewReader(packed.Bytes()), new(bytes.Buffer), decodeOptions{bin: "base64", keys: "error"})
+	if err == nil || !strings.Contains(err.Error(), "not a string") {
+		t.Errorf("expected a non-string key error, got %v", err)
+	}
+}
+
+func TestDecodeTruncated(t *testing.T) {
+	packed := new(bytes.Buffer)
+	mpack.Pack(packed, "complete")
+	mpack.Pack(packed, []interface{}{1, 2, 3})
+	data := packed.Bytes()[:packed.Len()-1]
+
+	out := new(bytes.Buffer)
+	err := decode(bytes.NewReader(data), out, decodeOptions{bin: "base64", keys: "string"})
+	if err == nil {
+		t.Error("expected an error for a truncated stream")
+	}
+	if out.String() != "\"complete\"\n" {
+		t.Errorf("expected the complete value to be written, got %q", out.String())
+	}
+}
+
+func TestDecodeContainerKeys(t *testing.T) {
+	// {[1]: 1, {"a": nil}: 2}, which no Go map can hold
+	data := []byte{0x82, 0x91, 0x01, 0x01, 0x81, 0xa1, 'a', 0xc0, 0x02}
+	cases := []struct {
+		keys     string
+		expected string
+	}{
+		{"json", `{"[1]":1,"{\"a\":null}":2}`},
+		{"string", `{"[1]":1,"map[a:<nil>]":2}`},
+	}
+	for _, c := range cases {
+		out := new(bytes.Buffer)
+		err := decode(bytes.NewReader(data), out, decodeOptions{bin: "base64", keys: c.keys})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out.String() != c.expected+"\n" {
+			t.Errorf("-keys %s: expected %s, got %s", c.keys, c.expected, out.String())
+		}
+	}
+
+	err := decode(bytes.NewReader(data), new(bytes.Buffer), decodeOptions{bin: "base64", keys: "error"})
+	if err == nil || !strings.Contains(err.Error(), "not a string") {
+		t.Errorf("expected a non-string key error, got %v", err)
+	}
+}
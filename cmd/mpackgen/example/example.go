@@ -0,0 +1,41 @@
+// Package example has types with methods generated by mpackgen.
+package example
+
+import "time"
+
+//go:generate mpackgen example.go
+
+//mpack:generate
+type Item struct {
+	SKU      string `msgpack:"sku"`
+	Quantity int32  `msgpack:"qty"`
+	Price    float64
+	Weight   float32 `msgpack:",omitempty"`
+}
+
+//mpack:generate
+type Order struct {
+	ID       uint64 `msgpack:"id"`
+	Customer string `msgpack:"customer"`
+	Items    []Item `msgpack:"items"`
+	Tags     []string
+	Counts   map[string]int `msgpack:",omitempty"`
+	Sizes    []uint16
+	Paid     bool
+	Note     []byte    `msgpack:"note,omitempty"`
+	Shipping *Item     `msgpack:"shipping,omitempty"`
+	Placed   time.Time `msgpack:"placed"`
+	Extra    interface{}
+	Rate     int8
+	Batches  map[string][]Item
+	Grid     [][]int64
+
+	internal int
+	Ignored  string `msgpack:"-"`
+}
+
+//mpack:generate
+type Node struct {
+	Name string
+	Kids []Node `msgpack:",omitempty"`
+}
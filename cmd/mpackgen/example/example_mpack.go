@@ -0,0 +1,901 @@
+// Code generated by mpackgen. DO NOT EDIT.
+
+package example
+
+import (
+	"mpack"
+	"reflect"
+)
+
+// EncodeMsgpack packs x as a map, like the reflection encoder.
+func (x Item) EncodeMsgpack(pw *mpack.PackWriter) (int, error) {
+	count := 3
+	if x.Weight != 0 {
+		count++
+	}
+	numBytes, err := pw.WriteMapHeader(count)
+	if err != nil {
+		return numBytes, err
+	}
+	var n int
+	n, err = pw.WriteString("sku")
+	numBytes += n
+	if err != nil {
+		return numBytes, err
+	}
+	n, err = pw.WriteString(x.SKU)
+	numBytes += n
+	if err != nil {
+		return numBytes, err
+	}
+	n, err = pw.WriteString("qty")
+	numBytes += n
+	if err != nil {
+		return numBytes, err
+	}
+	n, err = pw.WriteInt(int64(x.Quantity))
+	numBytes += n
+	if err != nil {
+		return numBytes, err
+	}
+	n, err = pw.WriteString("Price")
+	numBytes += n
+	if err != nil {
+		return numBytes, err
+	}
+	n, err = pw.WriteFloat(x.Price)
+	numBytes += n
+	if err != nil {
+		return numBytes, err
+	}
+	if x.Weight != 0 {
+		n, err = pw.WriteString("Weight")
+		numBytes += n
+		if err != nil {
+			return numBytes, err
+		}
+		n, err = pw.WriteFloat32(x.Weight)
+		numBytes += n
+		if err != nil {
+			return numBytes, err
+		}
+	}
+	return numBytes, nil
+}
+
+// DecodeMsgpack reads a map into x.  keys are matched exactly, and
+// unknown keys are skipped.
+func (x *Item) DecodeMsgpack(pr *mpack.PackReader) error {
+	count, err := pr.ReadMapHeader()
+	if err != nil {
+		return err
+	}
+	for i := 0; i < count; i++ {
+		var key string
+		key, err = pr.ReadString()
+		if err != nil {
+			return err
+		}
+		switch key {
+		case "sku":
+			x.SKU, err = pr.ReadString()
+			if err != nil {
+				return err
+			}
+		case "qty":
+			var v1 int64
+			v1, err = pr.ReadInt()
+			if err != nil {
+				return err
+			}
+			if int64(int32(v1)) != v1 {
+				return &mpack.UnmarshalTypeError{Value: "int", Type: reflect.TypeOf(x.Quantity)}
+			}
+			x.Quantity = int32(v1)
+		case "Price":
+			x.Price, err = pr.ReadFloat()
+			if err != nil {
+				return err
+			}
+		case "Weight":
+			var v2 float64
+			v2, err = pr.ReadFloat()
+			if err != nil {
+				return err
+			}
+			x.Weight = float32(v2)
+		default:
+			err = pr.Skip()
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// MsgpackSize returns an upper bound on the packed size of x.
+func (x Item) MsgpackSize() int {
+	size := 49
+	size += 5 + len(x.SKU)
+	return size
+}
+
+// EncodeMsgpack packs x as a map, like the reflection encoder.
+func (x Order) EncodeMsgpack(pw *mpack.PackWriter) (int, error) {
+	count := 11
+	if len(x.Counts) != 0 {
+		count++
+	}
+	if len(x.Note) != 0 {
+		count++
+	}
+	if x.Shipping != nil {
+		count++
+	}
+	numBytes, err := pw.WriteMapHeader(count)
+	if err != nil {
+		return numBytes, err
+	}
+	var n int
+	n, err = pw.WriteString("id")
+	numBytes += n
+	if err != nil {
+		return numBytes, err
+	}
+	n, err = pw.WriteUint(x.ID)
+	numBytes += n
+	if err != nil {
+		return numBytes, err
+	}
+	n, err = pw.WriteString("customer")
+	numBytes += n
+	if err != nil {
+		return numBytes, err
+	}
+	n, err = pw.WriteString(x.Customer)
+	numBytes += n
+	if err != nil {
+		return numBytes, err
+	}
+	n, err = pw.WriteString("items")
+	numBytes += n
+	if err != nil {
+		return numBytes, err
+	}
+	var a2 interface{}
+	if pw.Tracking() {
+		a2 = x.Items
+	}
+	err = pw.Enter(a2)
+	if err != nil {
+		return numBytes, err
+	}
+	n, err = pw.WriteArrayHeader(len(x.Items))
+	numBytes += n
+	if err != nil {
+		return numBytes, err
+	}
+	for _, e1 := range x.Items {
+		err = pw.Enter(nil)
+		if err != nil {
+			return numBytes, err
+		}
+		n, err = e1.EncodeMsgpack(pw)
+		numBytes += n
+		if err != nil {
+			return numBytes, err
+		}
+		pw.Leave(nil)
+	}
+	pw.Leave(a2)
+	n, err = pw.WriteString("Tags")
+	numBytes += n
+	if err != nil {
+		return numBytes, err
+	}
+	var a4 interface{}
+	if pw.Tracking() {
+		a4 = x.Tags
+	}
+	err = pw.Enter(a4)
+	if err != nil {
+		return numBytes, err
+	}
+	n, err = pw.WriteArrayHeader(len(x.Tags))
+	numBytes += n
+	if err != nil {
+		return numBytes, err
+	}
+	for _, e3 := range x.Tags {
+		n, err = pw.WriteString(e3)
+		numBytes += n
+		if err != nil {
+			return numBytes, err
+		}
+	}
+	pw.Leave(a4)
+	if len(x.Counts) != 0 {
+		n, err = pw.WriteString("Counts")
+		numBytes += n
+		if err != nil {
+			return numBytes, err
+		}
+		if pw.Canonical {
+			n, err = pw.Encode(x.Counts)
+			numBytes += n
+			if err != nil {
+				return numBytes, err
+			}
+		} else {
+			var a7 interface{}
+			if pw.Tracking() {
+				a7 = x.Counts
+			}
+			err = pw.Enter(a7)
+			if err != nil {
+				return numBytes, err
+			}
+			n, err = pw.WriteMapHeader(len(x.Counts))
+			numBytes += n
+			if err != nil {
+				return numBytes, err
+			}
+			for k5, e6 := range x.Counts {
+				n, err = pw.WriteString(k5)
+				numBytes += n
+				if err != nil {
+					return numBytes, err
+				}
+				n, err = pw.WriteInt(int64(e6))
+				numBytes += n
+				if err != nil {
+					return numBytes, err
+				}
+			}
+			pw.Leave(a7)
+		}
+	}
+	n, err = pw.WriteString("Sizes")
+	numBytes += n
+	if err != nil {
+		return numBytes, err
+	}
+	var a9 interface{}
+	if pw.Tracking() {
+		a9 = x.Sizes
+	}
+	err = pw.Enter(a9)
+	if err != nil {
+		return numBytes, err
+	}
+	n, err = pw.WriteArrayHeader(len(x.Sizes))
+	numBytes += n
+	if err != nil {
+		return numBytes, err
+	}
+	for _, e8 := range x.Sizes {
+		n, err = pw.WriteUint(uint64(e8))
+		numBytes += n
+		if err != nil {
+			return numBytes, err
+		}
+	}
+	pw.Leave(a9)
+	n, err = pw.WriteString("Paid")
+	numBytes += n
+	if err != nil {
+		return numBytes, err
+	}
+	n, err = pw.WriteBool(x.Paid)
+	numBytes += n
+	if err != nil {
+		return numBytes, err
+	}
+	if len(x.Note) != 0 {
+		n, err = pw.WriteString("note")
+		numBytes += n
+		if err != nil {
+			return numBytes, err
+		}
+		n, err = pw.WriteBytes(x.Note)
+		numBytes += n
+		if err != nil {
+			return numBytes, err
+		}
+	}
+	if x.Shipping != nil {
+		n, err = pw.WriteString("shipping")
+		numBytes += n
+		if err != nil {
+			return numBytes, err
+		}
+		n, err = pw.Encode(x.Shipping)
+		numBytes += n
+		if err != nil {
+			return numBytes, err
+		}
+	}
+	n, err = pw.WriteString("placed")
+	numBytes += n
+	if err != nil {
+		return numBytes, err
+	}
+	n, err = pw.Encode(x.Placed)
+	numBytes += n
+	if err != nil {
+		return numBytes, err
+	}
+	n, err = pw.WriteString("Extra")
+	numBytes += n
+	if err != nil {
+		return numBytes, err
+	}
+	n, err = pw.Encode(x.Extra)
+	numBytes += n
+	if err != nil {
+		return numBytes, err
+	}
+	n, err = pw.WriteString("Rate")
+	numBytes += n
+	if err != nil {
+		return numBytes, err
+	}
+	n, err = pw.WriteInt(int64(x.Rate))
+	numBytes += n
+	if err != nil {
+		return numBytes, err
+	}
+	n, err = pw.WriteString("Batches")
+	numBytes += n
+	if err != nil {
+		return numBytes, err
+	}
+	if pw.Canonical {
+		n, err = pw.Encode(x.Batches)
+		numBytes += n
+		if err != nil {
+			return numBytes, err
+		}
+	} else {
+		var a12 interface{}
+		if pw.Tracking() {
+			a12 = x.Batches
+		}
+		err = pw.Enter(a12)
+		if err != nil {
+			return numBytes, err
+		}
+		n, err = pw.WriteMapHeader(len(x.Batches))
+		numBytes += n
+		if err != nil {
+			return numBytes, err
+		}
+		for k10, e11 := range x.Batches {
+			n, err = pw.WriteString(k10)
+			numBytes += n
+			if err != nil {
+				return numBytes, err
+			}
+			var a14 interface{}
+			if pw.Tracking() {
+				a14 = e11
+			}
+			err = pw.Enter(a14)
+			if err != nil {
+				return numBytes, err
+			}
+			n, err = pw.WriteArrayHeader(len(e11))
+			numBytes += n
+			if err != nil {
+				return numBytes, err
+			}
+			for _, e13 := range e11 {
+				err = pw.Enter(nil)
+				if err != nil {
+					return numBytes, err
+				}
+				n, err = e13.EncodeMsgpack(pw)
+				numBytes += n
+				if err != nil {
+					return numBytes, err
+				}
+				pw.Leave(nil)
+			}
+			pw.Leave(a14)
+		}
+		pw.Leave(a12)
+	}
+	n, err = pw.WriteString("Grid")
+	numBytes += n
+	if err != nil {
+		return numBytes, err
+	}
+	var a16 interface{}
+	if pw.Tracking() {
+		a16 = x.Grid
+	}
+	err = pw.Enter(a16)
+	if err != nil {
+		return numBytes, err
+	}
+	n, err = pw.WriteArrayHeader(len(x.Grid))
+	numBytes += n
+	if err != nil {
+		return numBytes, err
+	}
+	for _, e15 := range x.Grid {
+		var a18 interface{}
+		if pw.Tracking() {
+			a18 = e15
+		}
+		err = pw.Enter(a18)
+		if err != nil {
+			return numBytes, err
+		}
+		n, err = pw.WriteArrayHeader(len(e15))
+		numBytes += n
+		if err != nil {
+			return numBytes, err
+		}
+		for _, e17 := range e15 {
+			n, err = pw.WriteInt(e17)
+			numBytes += n
+			if err != nil {
+				return numBytes, err
+			}
+		}
+		pw.Leave(a18)
+	}
+	pw.Leave(a16)
+	return numBytes, nil
+}
+
+// DecodeMsgpack reads a map into x.  keys are matched exactly, and
+// unknown keys are skipped.
+func (x *Order) DecodeMsgpack(pr *mpack.PackReader) error {
+	count, err := pr.ReadMapHeader()
+	if err != nil {
+		return err
+	}
+	for i := 0; i < count; i++ {
+		var key string
+		key, err = pr.ReadString()
+		if err != nil {
+			return err
+		}
+		switch key {
+		case "id":
+			x.ID, err = pr.ReadUint()
+			if err != nil {
+				return err
+			}
+		case "customer":
+			x.Customer, err = pr.ReadString()
+			if err != nil {
+				return err
+			}
+		case "items":
+			err = pr.Enter()
+			if err != nil {
+				return err
+			}
+			var n1 int
+			n1, err = pr.ReadArrayHeader()
+			if err != nil {
+				return err
+			}
+			if n1 < 0 {
+				x.Items = nil
+			} else {
+				x.Items = make([]Item, 0, min(n1, 1024))
+				for i2 := 0; i2 < n1; i2++ {
+					var e3 Item
+					err = pr.Enter()
+					if err != nil {
+						return err
+					}
+					err = e3.DecodeMsgpack(pr)
+					if err != nil {
+						return err
+					}
+					pr.Leave()
+					x.Items = append(x.Items, e3)
+				}
+			}
+			pr.Leave()
+		case "Tags":
+			err = pr.Enter()
+			if err != nil {
+				return err
+			}
+			var n4 int
+			n4, err = pr.ReadArrayHeader()
+			if err != nil {
+				return err
+			}
+			if n4 < 0 {
+				x.Tags = nil
+			} else {
+				x.Tags = make([]string, 0, min(n4, 1024))
+				for i5 := 0; i5 < n4; i5++ {
+					var e6 string
+					e6, err = pr.ReadString()
+					if err != nil {
+						return err
+					}
+					x.Tags = append(x.Tags, e6)
+				}
+			}
+			pr.Leave()
+		case "Counts":
+			err = pr.Enter()
+			if err != nil {
+				return err
+			}
+			var n7 int
+			n7, err = pr.ReadMapHeader()
+			if err != nil {
+				return err
+			}
+			if n7 < 0 {
+				x.Counts = nil
+			} else {
+				x.Counts = make(map[string]int, min(n7, 1024))
+				for i8 := 0; i8 < n7; i8++ {
+					var k9 string
+					k9, err = pr.ReadString()
+					if err != nil {
+						return err
+					}
+					var e10 int
+					var v11 int64
+					v11, err = pr.ReadInt()
+					if err != nil {
+						return err
+					}
+					if int64(int(v11)) != v11 {
+						return &mpack.UnmarshalTypeError{Value: "int", Type: reflect.TypeOf(e10)}
+					}
+					e10 = int(v11)
+					x.Counts[k9] = e10
+				}
+			}
+			pr.Leave()
+		case "Sizes":
+			err = pr.Enter()
+			if err != nil {
+				return err
+			}
+			var n12 int
+			n12, err = pr.ReadArrayHeader()
+			if err != nil {
+				return err
+			}
+			if n12 < 0 {
+				x.Sizes = nil
+			} else {
+				x.Sizes = make([]uint16, 0, min(n12, 1024))
+				for i13 := 0; i13 < n12; i13++ {
+					var e14 uint16
+					var v15 uint64
+					v15, err = pr.ReadUint()
+					if err != nil {
+						return err
+					}
+					if uint64(uint16(v15)) != v15 {
+						return &mpack.UnmarshalTypeError{Value: "uint", Type: reflect.TypeOf(e14)}
+					}
+					e14 = uint16(v15)
+					x.Sizes = append(x.Sizes, e14)
+				}
+			}
+			pr.Leave()
+		case "Paid":
+			x.Paid, err = pr.ReadBool()
+			if err != nil {
+				return err
+			}
+		case "note":
+			x.Note, err = pr.ReadBytes()
+			if err != nil {
+				return err
+			}
+		case "shipping":
+			err = pr.Decode(&x.Shipping)
+			if err != nil {
+				return err
+			}
+		case "placed":
+			err = pr.Decode(&x.Placed)
+			if err != nil {
+				return err
+			}
+		case "Extra":
+			err = pr.Decode(&x.Extra)
+			if err != nil {
+				return err
+			}
+		case "Rate":
+			var v16 int64
+			v16, err = pr.ReadInt()
+			if err != nil {
+				return err
+			}
+			if int64(int8(v16)) != v16 {
+				return &mpack.UnmarshalTypeError{Value: "int", Type: reflect.TypeOf(x.Rate)}
+			}
+			x.Rate = int8(v16)
+		case "Batches":
+			err = pr.Enter()
+			if err != nil {
+				return err
+			}
+			var n17 int
+			n17, err = pr.ReadMapHeader()
+			if err != nil {
+				return err
+			}
+			if n17 < 0 {
+				x.Batches = nil
+			} else {
+				x.Batches = make(map[string][]Item, min(n17, 1024))
+				for i18 := 0; i18 < n17; i18++ {
+					var k19 string
+					k19, err = pr.ReadString()
+					if err != nil {
+						return err
+					}
+					var e20 []Item
+					err = pr.Enter()
+					if err != nil {
+						return err
+					}
+					var n21 int
+					n21, err = pr.ReadArrayHeader()
+					if err != nil {
+						return err
+					}
+					if n21 < 0 {
+						e20 = nil
+					} else {
+						e20 = make([]Item, 0, min(n21, 1024))
+						for i22 := 0; i22 < n21; i22++ {
+							var e23 Item
+							err = pr.Enter()
+							if err != nil {
+								return err
+							}
+							err = e23.DecodeMsgpack(pr)
+							if err != nil {
+								return err
+							}
+							pr.Leave()
+							e20 = append(e20, e23)
+						}
+					}
+					pr.Leave()
+					x.Batches[k19] = e20
+				}
+			}
+			pr.Leave()
+		case "Grid":
+			err = pr.Enter()
+			if err != nil {
+				return err
+			}
+			var n24 int
+			n24, err = pr.ReadArrayHeader()
+			if err != nil {
+				return err
+			}
+			if n24 < 0 {
+				x.Grid = nil
+			} else {
+				x.Grid = make([][]int64, 0, min(n24, 1024))
+				for i25 := 0; i25 < n24; i25++ {
+					var e26 []int64
+					err = pr.Enter()
+					if err != nil {
+						return err
+					}
+					var n27 int
+					n27, err = pr.ReadArrayHeader()
+					if err != nil {
+						return err
+					}
+					if n27 < 0 {
+						e26 = nil
+					} else {
+						e26 = make([]int64, 0, min(n27, 1024))
+						for i28 := 0; i28 < n27; i28++ {
+							var e29 int64
+							e29, err = pr.ReadInt()
+							if err != nil {
+								return err
+							}
+							e26 = append(e26, e29)
+						}
+					}
+					pr.Leave()
+					x.Grid = append(x.Grid, e26)
+				}
+			}
+			pr.Leave()
+		default:
+			err = pr.Skip()
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// MsgpackSize returns an upper bound on the packed size of x.
+func (x Order) MsgpackSize() int {
+	size := 110
+	size += 5 + len(x.Customer)
+	size += 5
+	for _, e1 := range x.Items {
+		size += e1.MsgpackSize()
+	}
+	size += 5
+	for _, e2 := range x.Tags {
+		size += 5 + len(e2)
+	}
+	size += 5
+	for k3 := range x.Counts {
+		size += 5 + len(k3) + 9
+	}
+	size += 5 + len(x.Sizes)*9
+	size += 5 + len(x.Note)
+	if n, err := mpack.EncodedSize(x.Shipping); err == nil {
+		size += n
+	}
+	if n, err := mpack.EncodedSize(x.Placed); err == nil {
+		size += n
+	}
+	if n, err := mpack.EncodedSize(x.Extra); err == nil {
+		size += n
+	}
+	size += 5
+	for k4, e5 := range x.Batches {
+		size += 5 + len(k4)
+		size += 5
+		for _, e6 := range e5 {
+			size += e6.MsgpackSize()
+		}
+	}
+	size += 5
+	for _, e7 := range x.Grid {
+		size += 5 + len(e7)*9
+	}
+	return size
+}
+
+// EncodeMsgpack packs x as a map, like the reflection encoder.
+func (x Node) EncodeMsgpack(pw *mpack.PackWriter) (int, error) {
+	count := 1
+	if len(x.Kids) != 0 {
+		count++
+	}
+	numBytes, err := pw.WriteMapHeader(count)
+	if err != nil {
+		return numBytes, err
+	}
+	var n int
+	n, err = pw.WriteString("Name")
+	numBytes += n
+	if err != nil {
+		return numBytes, err
+	}
+	n, err = pw.WriteString(x.Name)
+	numBytes += n
+	if err != nil {
+		return numBytes, err
+	}
+	if len(x.Kids) != 0 {
+		n, err = pw.WriteString("Kids")
+		numBytes += n
+		if err != nil {
+			return numBytes, err
+		}
+		var a2 interface{}
+		if pw.Tracking() {
+			a2 = x.Kids
+		}
+		err = pw.Enter(a2)
+		if err != nil {
+			return numBytes, err
+		}
+		n, err = pw.WriteArrayHeader(len(x.Kids))
+		numBytes += n
+		if err != nil {
+			return numBytes, err
+		}
+		for _, e1 := range x.Kids {
+			err = pw.Enter(nil)
+			if err != nil {
+				return numBytes, err
+			}
+			n, err = e1.EncodeMsgpack(pw)
+			numBytes += n
+			if err != nil {
+				return numBytes, err
+			}
+			pw.Leave(nil)
+		}
+		pw.Leave(a2)
+	}
+	return numBytes, nil
+}
+
+// DecodeMsgpack reads a map into x.  keys are matched exactly, and
+// unknown keys are skipped.
+func (x *Node) DecodeMsgpack(pr *mpack.PackReader) error {
+	count, err := pr.ReadMapHeader()
+	if err != nil {
+		return err
+	}
+	for i := 0; i < count; i++ {
+		var key string
+		key, err = pr.ReadString()
+		if err != nil {
+			return err
+		}
+		switch key {
+		case "Name":
+			x.Name, err = pr.ReadString()
+			if err != nil {
+				return err
+			}
+		case "Kids":
+			err = pr.Enter()
+			if err != nil {
+				return err
+			}
+			var n1 int
+			n1, err = pr.ReadArrayHeader()
+			if err != nil {
+				return err
+			}
+			if n1 < 0 {
+				x.Kids = nil
+			} else {
+				x.Kids = make([]Node, 0, min(n1, 1024))
+				for i2 := 0; i2 < n1; i2++ {
+					var e3 Node
+					err = pr.Enter()
+					if err != nil {
+						return err
+					}
+					err = e3.DecodeMsgpack(pr)
+					if err != nil {
+						return err
+					}
+					pr.Leave()
+					x.Kids = append(x.Kids, e3)
+				}
+			}
+			pr.Leave()
+		default:
+			err = pr.Skip()
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// MsgpackSize returns an upper bound on the packed size of x.
+func (x Node) MsgpackSize() int {
+	size := 15
+	size += 5 + len(x.Name)
+	size += 5
+	for _, e1 := range x.Kids {
+		size += e1.MsgpackSize()
+	}
+	return size
+}
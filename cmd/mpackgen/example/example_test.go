@@ -0,0 +1,199 @@
+package example
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"mpack"
+)
+
+// the same types without the generated methods, packed by reflection
+type plainItem Item
+type plainOrder Order
+
+func testOrder() Order {
+	return Order{
+		ID:       1 << 40,
+		Customer: "acme",
+		Items:    []Item{{SKU: "a-1", Quantity: 3, Price: 9.5}, {SKU: "b-2", Quantity: -1, Price: 0.25, Weight: 1.5}},
+		Tags:     []string{"rush", "gift"},
+		Counts:   map[string]int{"x": 1, "y": 300, "z": -70000},
+		Sizes:    []uint16{1, 200, 60000},
+		Paid:     true,
+		Shipping: &Item{SKU: "ship", Quantity: 1, Price: 4},
+		Placed:   time.Unix(1700000000, 5000),
+		Extra:    "anything",
+		Rate:     -7,
+		Batches:  map[string][]Item{"first": {{SKU: "c", Quantity: 2}}, "empty": {}},
+		Grid:     [][]int64{{1, -2}, {}, {1 << 50}},
+	}
+}
+
+func canonical(t *testing.T, value interface{}) []byte {
+	b := new(bytes.Buffer)
+	pw := mpack.NewPackWriter(b)
+	pw.Canonical = true
+	_, err := pw.Encode(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b.Bytes()
+}
+
+func TestMatchesReflection(t *testing.T) {
+	order := testOrder()
+	if !bytes.Equal(canonical(t, order), canonical(t, plainOrder(order))) {
+		t.Error("generated Order encoding differs from reflection")
+	}
+	item := order.Items[1]
+	if !bytes.Equal(canonical(t, item), canonical(t, plainItem(item))) {
+		t.Error("generated Item encoding differs from reflection")
+	}
+	var empty Order
+	if !bytes.Equal(canonical(t, empty), canonical(t, plainOrder(empty))) {
+		t.Error("generated encoding of a zero Order differs from reflection")
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	order := testOrder()
+	b := new(bytes.Buffer)
+	n, err := order.EncodeMsgpack(mpack.NewPackWriter(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != b.Len() {
+		t.Errorf("EncodeMsgpack returned %d, wrote %d bytes", n, b.Len())
+	}
+	if size := order.MsgpackSize(); size < n {
+		t.Errorf("MsgpackSize %d is less than the packed size %d", size, n)
+	}
+
+	var decoded Order
+	err = decoded.DecodeMsgpack(mpack.NewPackReader(bytes.NewReader(b.Bytes())))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, order) {
+		t.Errorf("expected %#v, got %#v", order, decoded)
+	}
+
+	// Unmarshal uses the generated decoder, and reads what reflection packs
+	var unmarshaled Order
+	err = mpack.Unmarshal(canonical(t, plainOrder(order)), &unmarshaled)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(unmarshaled, order) {
+		t.Errorf("expected %#v, got %#v", order, unmarshaled)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	data := canonical(t, map[string]interface{}{"qty": 1 << 40})
+	var item Item
+	err := mpack.Unmarshal(data, &item)
+	if _, ok := err.(*mpack.UnmarshalTypeError); !ok {
+		t.Errorf("expected an *UnmarshalTypeError, got %v", err)
+	}
+
+	data = canonical(t, map[string]interface{}{"sku": 5})
+	err = mpack.Unmarshal(data, &item)
+	if _, ok := err.(*mpack.UnmarshalTypeError); !ok {
+		t.Errorf("expected an *UnmarshalTypeError, got %v", err)
+	}
+
+	data = canonical(t, testOrder())
+	err = mpack.Unmarshal(data[:len(data)/2], new(Order))
+	if err != io.ErrUnexpectedEOF {
+		t.Errorf("expected io.ErrUnexpectedEOF, got %v", err)
+	}
+}
+
+func TestDecodePointers(t *testing.T) {
+	// pointers use the generated decoder too, which matches keys exactly
+	// where reflection doesn't
+	item := map[string]interface{}{"SKU": "x", "qty": 2}
+	var order Order
+	err := mpack.Unmarshal(canonical(t, map[string]interface{}{"shipping": item}), &order)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if order.Shipping == nil || order.Shipping.SKU != "" || order.Shipping.Quantity != 2 {
+		t.Errorf("expected Shipping to be decoded by its generated decoder, got %+v", order.Shipping)
+	}
+
+	var items []*Item
+	err = mpack.Unmarshal(canonical(t, []interface{}{item, nil}), &items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 2 || items[0] == nil || items[0].SKU != "" || items[0].Quantity != 2 || items[1] != nil {
+		t.Errorf("unexpected items %v", items)
+	}
+
+	err = mpack.Unmarshal(canonical(t, map[string]interface{}{"shipping": nil}), &order)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if order.Shipping != nil {
+		t.Errorf("expected nil to clear Shipping, got %+v", order.Shipping)
+	}
+}
+
+func TestDepthLimits(t *testing.T) {
+	pw := mpack.NewPackWriter(io.Discard)
+	pw.MaxDepth = 1
+	_, err := pw.Encode(testOrder())
+	var limit *mpack.LimitError
+	if !errors.As(err, &limit) || limit.Limit != "depth" {
+		t.Errorf("expected a depth *LimitError from Encode, got %v", err)
+	}
+
+	data := canonical(t, testOrder())
+	pr := mpack.NewPackReaderOptions(bytes.NewReader(data), mpack.DecoderOptions{MaxDepth: 1})
+	err = pr.Decode(new(Order))
+	if !errors.As(err, &limit) || limit.Limit != "depth" {
+		t.Errorf("expected a depth *LimitError from Decode, got %v", err)
+	}
+
+	// {"Kids": [{"Kids": [...]}]}, far deeper than the default limit
+	var deep []byte
+	for i := 0; i < 100000; i++ {
+		deep = append(deep, 0x81, 0xa4, 'K', 'i', 'd', 's', 0x91)
+	}
+	deep = append(deep, 0x80)
+	pr = mpack.NewPackReaderOptions(bytes.NewReader(deep), mpack.DefaultDecoderOptions)
+	err = pr.Decode(new(Node))
+	if !errors.As(err, &limit) || limit.Limit != "depth" {
+		t.Errorf("expected a depth *LimitError for deeply nested nodes, got %v", err)
+	}
+
+	node := Node{Name: "root"}
+	node.Kids = []Node{{Name: "child"}}
+	node.Kids[0].Kids = node.Kids
+	_, err = mpack.NewPackWriter(io.Discard).Encode(node)
+	if !errors.Is(err, mpack.ErrCycle) {
+		t.Errorf("expected ErrCycle, got %v", err)
+	}
+}
+
+func BenchmarkEncodeGenerated(b *testing.B) {
+	order := testOrder()
+	pw := mpack.NewPackWriter(io.Discard)
+	for i := 0; i < b.N; i++ {
+		pw.Encode(order)
+	}
+}
+
+func BenchmarkEncodeReflection(b *testing.B) {
+	order := plainOrder(testOrder())
+	pw := mpack.NewPackWriter(io.Discard)
+	for i := 0; i < b.N; i++ {
+		pw.Encode(order)
+	}
+}
@@ -0,0 +1,617 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// types are generated for when their declaration has this comment
+const annotation = "//mpack:generate"
+
+// most elements a generated decoder allocates for before reading them,
+// the same as the reflection decoder
+const preallocLimit = 1024
+
+// an annotated struct type
+type structType struct {
+	name   string
+	fields []structField
+}
+
+type structField struct {
+	name      string // Go field name
+	key       string // name on the wire
+	omitEmpty bool
+	typ       ast.Expr
+}
+
+// how a type is packed
+type kind int
+
+const (
+	otherKind kind = iota // anything else, handed to the reflection encoder
+	boolKind
+	intKind
+	uintKind
+	floatKind
+	stringKind
+	bytesKind
+	sliceKind
+	mapKind    // map with string keys
+	structKind // another annotated type
+)
+
+var basicKinds = map[string]kind{
+	"bool":    boolKind,
+	"int":     intKind,
+	"int8":    intKind,
+	"int16":   intKind,
+	"int32":   intKind,
+	"int64":   intKind,
+	"rune":    intKind,
+	"uint":    uintKind,
+	"uint8":   uintKind,
+	"uint16":  uintKind,
+	"uint32":  uintKind,
+	"uint64":  uintKind,
+	"byte":    uintKind,
+	"float32": floatKind,
+	"float64": floatKind,
+	"string":  stringKind,
+}
+
+// parseFile returns the package name and annotated struct types of a
+// Go source file
+func parseFile(filename string, src []byte) (string, []structType, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var structs []structType
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			ts := spec.(*ast.TypeSpec)
+			if !annotated(gd.Doc) && !annotated(ts.Doc) {
+				continue
+			}
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok {
+				return "", nil, fmt.Errorf("%s: %s is not a struct", fset.Position(ts.Pos()), ts.Name.Name)
+			}
+			s, err := parseStruct(ts.Name.Name, st)
+			if err != nil {
+				return "", nil, fmt.Errorf("%s: %s", fset.Position(ts.Pos()), err)
+			}
+			structs = append(structs, s)
+		}
+	}
+	return f.Name.Name, structs, nil
+}
+
+func annotated(doc *ast.CommentGroup) bool {
+	if doc == nil {
+		return false
+	}
+	for _, c := range doc.List {
+		if strings.TrimSpace(c.Text) == annotation {
+			return true
+		}
+	}
+	return false
+}
+
+// the fields of a struct, following the same tag rules as the reflection
+// encoder
+func parseStruct(name string, st *ast.StructType) (structType, error) {
+	s := structType{name: name}
+	keys := make(map[string]bool)
+	for _, f := range st.Fields.List {
+		if len(f.Names) == 0 {
+			return s, fmt.Errorf("embedded field %s in %s is not supported", types.ExprString(f.Type), name)
+		}
+		var tag string
+		if f.Tag != nil {
+			unquoted, err := strconv.Unquote(f.Tag.Value)
+			if err != nil {
+				return s, err
+			}
+			tag = reflect.StructTag(unquoted).Get("msgpack")
+		}
+		if tag == "-" {
+			continue
+		}
+		parts := strings.Split(tag, ",")
+		omitEmpty := false
+		for _, opt := range parts[1:] {
+			if opt == "omitempty" {
+				omitEmpty = true
+			}
+		}
+
+		for _, ident := range f.Names {
+			if !ident.IsExported() {
+				continue
+			}
+			key := parts[0]
+			if key == "" {
+				key = ident.Name
+			}
+			if keys[key] {
+				return s, fmt.Errorf("duplicate key %q in %s", key, name)
+			}
+			keys[key] = true
+			s.fields = append(s.fields, structField{name: ident.Name, key: key, omitEmpty: omitEmpty, typ: f.Type})
+		}
+	}
+	return s, nil
+}
+
+type generator struct {
+	buf bytes.Buffer
+
+	// names of all the annotated types in the package
+	structs map[string]bool
+
+	imports map[string]bool
+
+	// counter for temporary variable names, reset for each method
+	vars int
+}
+
+func newGenerator(structs map[string]bool) *generator {
+	return &generator{structs: structs, imports: make(map[string]bool)}
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func (g *generator) tmp(prefix string) string {
+	g.vars++
+	return fmt.Sprintf("%s%d", prefix, g.vars)
+}
+
+func (g *generator) kindOf(t ast.Expr) kind {
+	switch t := t.(type) {
+	case *ast.Ident:
+		if k, ok := basicKinds[t.Name]; ok {
+			return k
+		}
+		if g.structs[t.Name] {
+			return structKind
+		}
+	case *ast.ArrayType:
+		if t.Len != nil {
+			return otherKind
+		}
+		if elt, ok := t.Elt.(*ast.Ident); ok && (elt.Name == "byte" || elt.Name == "uint8") {
+			return bytesKind
+		}
+		return sliceKind
+	case *ast.MapType:
+		if key, ok := t.Key.(*ast.Ident); ok && key.Name == "string" {
+			return mapKind
+		}
+	}
+	return otherKind
+}
+
+// the 64 bit type a basic type is read and written as, and whether
+// converting back needs a range check
+func wideType(t ast.Expr) (string, bool) {
+	name := t.(*ast.Ident).Name
+	switch basicKinds[name] {
+	case intKind:
+		return "int64", name != "int64"
+	case uintKind:
+		return "uint64", name != "uint64"
+	}
+	return "float64", false
+}
+
+// an expression that is true if val is not empty, for omitempty
+func (g *generator) nonEmpty(t ast.Expr, val string) (string, error) {
+	switch g.kindOf(t) {
+	case boolKind:
+		return val, nil
+	case intKind, uintKind, floatKind:
+		return val + " != 0", nil
+	case stringKind:
+		return val + ` != ""`, nil
+	case bytesKind, sliceKind, mapKind:
+		return "len(" + val + ") != 0", nil
+	case structKind:
+		// structs are never empty
+		return "", nil
+	}
+	switch t := t.(type) {
+	case *ast.StarExpr, *ast.InterfaceType, *ast.FuncType, *ast.ChanType:
+		return val + " != nil", nil
+	case *ast.MapType:
+		return "len(" + val + ") != 0", nil
+	case *ast.ArrayType:
+		return "len(" + val + ") != 0", nil
+	case *ast.StructType:
+		return "", nil
+	default:
+		return "", fmt.Errorf("omitempty is not supported for type %s", types.ExprString(t))
+	}
+}
+
+// write is one step of an encoder: call writes something and returns
+// (int, error)
+func (g *generator) write(call string) {
+	g.printf("n, err = %s\n", call)
+	g.printf("numBytes += n\n")
+	g.printf("if err != nil {\nreturn numBytes, err\n}\n")
+}
+
+func (g *generator) encode(t ast.Expr, val string) {
+	switch g.kindOf(t) {
+	case boolKind:
+		g.write("pw.WriteBool(" + val + ")")
+	case intKind:
+		g.write("pw.WriteInt(" + convert("int64", t, val) + ")")
+	case uintKind:
+		g.write("pw.WriteUint(" + convert("uint64", t, val) + ")")
+	case floatKind:
+		if t.(*ast.Ident).Name == "float32" {
+			g.write("pw.WriteFloat32(" + val + ")")
+		} else {
+			g.write("pw.WriteFloat(" + val + ")")
+		}
+	case stringKind:
+		g.write("pw.WriteString(" + val + ")")
+	case bytesKind:
+		g.write("pw.WriteBytes(" + val + ")")
+	case structKind:
+		g.enterStruct()
+		g.write(val + ".EncodeMsgpack(pw)")
+		g.printf("pw.Leave(nil)\n")
+	case sliceKind:
+		elt := g.tmp("e")
+		active := g.enter(val)
+		g.write("pw.WriteArrayHeader(len(" + val + "))")
+		g.printf("for _, %s := range %s {\n", elt, val)
+		g.encode(t.(*ast.ArrayType).Elt, elt)
+		g.printf("}\n")
+		g.printf("pw.Leave(%s)\n", active)
+	case mapKind:
+		// the reflection encoder knows how to sort the keys
+		k, elt := g.tmp("k"), g.tmp("e")
+		g.printf("if pw.Canonical {\n")
+		g.write("pw.Encode(" + val + ")")
+		g.printf("} else {\n")
+		active := g.enter(val)
+		g.write("pw.WriteMapHeader(len(" + val + "))")
+		g.printf("for %s, %s := range %s {\n", k, elt, val)
+		g.write("pw.WriteString(" + k + ")")
+		g.encode(t.(*ast.MapType).Value, elt)
+		g.printf("}\n")
+		g.printf("pw.Leave(%s)\n}\n", active)
+	default:
+		g.write("pw.Encode(" + val + ")")
+	}
+}
+
+// count the slice or map val against the writer's MaxDepth, and return
+// the variable to hand to pw.Leave.  val is only boxed once the writer is
+// deep enough to look for cycles.
+func (g *generator) enter(val string) string {
+	active := g.tmp("a")
+	g.printf("var %s interface{}\n", active)
+	g.printf("if pw.Tracking() {\n%s = %s\n}\n", active, val)
+	g.printf("err = pw.Enter(%s)\n", active)
+	g.printf("if err != nil {\nreturn numBytes, err\n}\n")
+	return active
+}
+
+func (g *generator) enterStruct() {
+	g.printf("err = pw.Enter(nil)\n")
+	g.printf("if err != nil {\nreturn numBytes, err\n}\n")
+}
+
+func convert(wide string, t ast.Expr, val string) string {
+	if t.(*ast.Ident).Name == wide {
+		return val
+	}
+	return wide + "(" + val + ")"
+}
+
+func (g *generator) check() {
+	g.printf("if err != nil {\nreturn err\n}\n")
+}
+
+// decode the next value into target, which must be addressable
+func (g *generator) decode(t ast.Expr, target string) {
+	typ := types.ExprString(t)
+	switch g.kindOf(t) {
+	case boolKind:
+		g.printf("%s, err = pr.ReadBool()\n", target)
+		g.check()
+	case stringKind:
+		g.printf("%s, err = pr.ReadString()\n", target)
+		g.check()
+	case bytesKind:
+		g.printf("%s, err = pr.ReadBytes()\n", target)
+		g.check()
+	case intKind, uintKind, floatKind:
+		wide, narrow := wideType(t)
+		read := map[string]string{"int64": "ReadInt", "uint64": "ReadUint", "float64": "ReadFloat"}[wide]
+		if typ == wide {
+			g.printf("%s, err = pr.%s()\n", target, read)
+			g.check()
+			return
+		}
+		v := g.tmp("v")
+		g.printf("var %s %s\n", v, wide)
+		g.printf("%s, err = pr.%s()\n", v, read)
+		g.check()
+		if narrow {
+			g.imports["reflect"] = true
+			g.printf("if %s(%s(%s)) != %s {\n", wide, typ, v, v)
+			g.printf("return &mpack.UnmarshalTypeError{Value: %q, Type: reflect.TypeOf(%s)}\n", strings.TrimSuffix(wide, "64"), target)
+			g.printf("}\n")
+		}
+		g.printf("%s = %s(%s)\n", target, typ, v)
+	case structKind:
+		g.printf("err = pr.Enter()\n")
+		g.check()
+		g.printf("err = %s.DecodeMsgpack(pr)\n", target)
+		g.check()
+		g.printf("pr.Leave()\n")
+	case sliceKind:
+		// like the reflection decoder, the slice grows as its elements
+		// turn up rather than trusting the header
+		elt := t.(*ast.ArrayType).Elt
+		n, i, e := g.tmp("n"), g.tmp("i"), g.tmp("e")
+		g.printf("err = pr.Enter()\n")
+		g.check()
+		g.printf("var %s int\n", n)
+		g.printf("%s, err = pr.ReadArrayHeader()\n", n)
+		g.check()
+		g.printf("if %s < 0 {\n%s = nil\n} else {\n", n, target)
+		g.printf("%s = make(%s, 0, min(%s, %d))\n", target, typ, n, preallocLimit)
+		g.printf("for %s := 0; %s < %s; %s++ {\n", i, i, n, i)
+		g.printf("var %s %s\n", e, types.ExprString(elt))
+		g.decode(elt, e)
+		g.printf("%s = append(%s, %s)\n", target, target, e)
+		g.printf("}\n}\n")
+		g.printf("pr.Leave()\n")
+	case mapKind:
+		value := t.(*ast.MapType).Value
+		n, i, k, elt := g.tmp("n"), g.tmp("i"), g.tmp("k"), g.tmp("e")
+		g.printf("err = pr.Enter()\n")
+		g.check()
+		g.printf("var %s int\n", n)
+		g.printf("%s, err = pr.ReadMapHeader()\n", n)
+		g.check()
+		g.printf("if %s < 0 {\n%s = nil\n} else {\n", n, target)
+		g.printf("%s = make(%s, min(%s, %d))\n", target, typ, n, preallocLimit)
+		g.printf("for %s := 0; %s < %s; %s++ {\n", i, i, n, i)
+		g.printf("var %s string\n", k)
+		g.printf("%s, err = pr.ReadString()\n", k)
+		g.check()
+		g.printf("var %s %s\n", elt, types.ExprString(value))
+		g.decode(value, elt)
+		g.printf("%s[%s] = %s\n", target, k, elt)
+		g.printf("}\n}\n")
+		g.printf("pr.Leave()\n")
+	default:
+		g.printf("err = pr.Decode(&%s)\n", target)
+		g.check()
+	}
+}
+
+// the size of a value of type t if it doesn't depend on the value
+func (g *generator) fixedSize(t ast.Expr) (int, bool) {
+	switch g.kindOf(t) {
+	case boolKind:
+		return 1, true
+	case intKind, uintKind:
+		return 9, true
+	case floatKind:
+		if t.(*ast.Ident).Name == "float32" {
+			return 5, true
+		}
+		return 9, true
+	}
+	return 0, false
+}
+
+// add an upper bound on the packed size of val to size
+func (g *generator) size(t ast.Expr, val string) {
+	if n, ok := g.fixedSize(t); ok {
+		g.printf("size += %d\n", n)
+		return
+	}
+	switch g.kindOf(t) {
+	case stringKind, bytesKind:
+		g.printf("size += 5 + len(%s)\n", val)
+	case structKind:
+		g.printf("size += %s.MsgpackSize()\n", val)
+	case sliceKind:
+		elt := t.(*ast.ArrayType).Elt
+		if n, ok := g.fixedSize(elt); ok {
+			g.printf("size += 5 + len(%s)*%d\n", val, n)
+			return
+		}
+		e := g.tmp("e")
+		g.printf("size += 5\n")
+		g.printf("for _, %s := range %s {\n", e, val)
+		g.size(elt, e)
+		g.printf("}\n")
+	case mapKind:
+		value := t.(*ast.MapType).Value
+		k := g.tmp("k")
+		g.printf("size += 5\n")
+		if n, ok := g.fixedSize(value); ok {
+			g.printf("for %s := range %s {\n", k, val)
+			g.printf("size += 5 + len(%s) + %d\n", k, n)
+			g.printf("}\n")
+			return
+		}
+		e := g.tmp("e")
+		g.printf("for %s, %s := range %s {\n", k, e, val)
+		g.printf("size += 5 + len(%s)\n", k)
+		g.size(value, e)
+		g.printf("}\n")
+	default:
+		g.printf("if n, err := mpack.EncodedSize(%s); err == nil {\n", val)
+		g.printf("size += n\n")
+		g.printf("}\n")
+	}
+}
+
+// the packed size of a map key
+func keySize(key string) int {
+	switch {
+	case len(key) < 32:
+		return 1 + len(key)
+	case len(key) < 256:
+		return 2 + len(key)
+	case len(key) < 65536:
+		return 3 + len(key)
+	}
+	return 5 + len(key)
+}
+
+func (g *generator) encoder(s structType) error {
+	g.vars = 0
+	g.printf("// EncodeMsgpack packs x as a map, like the reflection encoder.\n")
+	g.printf("func (x %s) EncodeMsgpack(pw *mpack.PackWriter) (int, error) {\n", s.name)
+	conds := make([]string, len(s.fields))
+	required := 0
+	for i, f := range s.fields {
+		if f.omitEmpty {
+			cond, err := g.nonEmpty(f.typ, "x."+f.name)
+			if err != nil {
+				return fmt.Errorf("field %s of %s: %s", f.name, s.name, err)
+			}
+			conds[i] = cond
+		}
+		if conds[i] == "" {
+			required++
+		}
+	}
+	g.printf("count := %d\n", required)
+	for _, cond := range conds {
+		if cond != "" {
+			g.printf("if %s {\ncount++\n}\n", cond)
+		}
+	}
+	g.printf("numBytes, err := pw.WriteMapHeader(count)\n")
+	g.printf("if err != nil {\nreturn numBytes, err\n}\n")
+	if len(s.fields) > 0 {
+		g.printf("var n int\n")
+	}
+	for i, f := range s.fields {
+		if conds[i] != "" {
+			g.printf("if %s {\n", conds[i])
+		}
+		g.write("pw.WriteString(" + strconv.Quote(f.key) + ")")
+		g.encode(f.typ, "x."+f.name)
+		if conds[i] != "" {
+			g.printf("}\n")
+		}
+	}
+	g.printf("return numBytes, nil\n}\n\n")
+	return nil
+}
+
+func (g *generator) decoder(s structType) {
+	g.vars = 0
+	g.printf("// DecodeMsgpack reads a map into x.  keys are matched exactly, and\n")
+	g.printf("// unknown keys are skipped.\n")
+	g.printf("func (x *%s) DecodeMsgpack(pr *mpack.PackReader) error {\n", s.name)
+	g.printf("count, err := pr.ReadMapHeader()\n")
+	g.check()
+	g.printf("for i := 0; i < count; i++ {\n")
+	g.printf("var key string\n")
+	g.printf("key, err = pr.ReadString()\n")
+	g.check()
+	g.printf("switch key {\n")
+	for _, f := range s.fields {
+		g.printf("case %s:\n", strconv.Quote(f.key))
+		g.decode(f.typ, "x."+f.name)
+	}
+	g.printf("default:\n")
+	g.printf("err = pr.Skip()\n")
+	g.check()
+	g.printf("}\n}\nreturn nil\n}\n\n")
+}
+
+func (g *generator) sizer(s structType) {
+	g.vars = 0
+	fixed := 5
+	var dynamic []structField
+	for _, f := range s.fields {
+		fixed += keySize(f.key)
+		if n, ok := g.fixedSize(f.typ); ok {
+			fixed += n
+		} else {
+			dynamic = append(dynamic, f)
+		}
+	}
+	g.printf("// MsgpackSize returns an upper bound on the packed size of x.\n")
+	g.printf("func (x %s) MsgpackSize() int {\n", s.name)
+	if len(dynamic) == 0 {
+		g.printf("return %d\n}\n\n", fixed)
+		return
+	}
+	g.printf("size := %d\n", fixed)
+	for _, f := range dynamic {
+		g.size(f.typ, "x."+f.name)
+	}
+	g.printf("return size\n}\n\n")
+}
+
+// generate returns the gofmt'd source of the methods for structs
+func (g *generator) generate(pkg string, structs []structType) ([]byte, error) {
+	g.imports["mpack"] = true
+	for _, s := range structs {
+		err := g.encoder(s)
+		if err != nil {
+			return nil, err
+		}
+		g.decoder(s)
+		g.sizer(s)
+	}
+	body := g.buf.Bytes()
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by mpackgen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&out, "package %s\n\n", pkg)
+	imports := make([]string, 0, len(g.imports))
+	for path := range g.imports {
+		imports = append(imports, path)
+	}
+	sort.Strings(imports)
+	fmt.Fprintf(&out, "import (\n")
+	for _, path := range imports {
+		fmt.Fprintf(&out, "%q\n", path)
+	}
+	fmt.Fprintf(&out, ")\n\n")
+	out.Write(body)
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %s", err)
+	}
+	return src, nil
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files")
+
+func generateFile(t *testing.T, filename string) []byte {
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg, structs, err := parseFile(filename, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := make(map[string]bool)
+	for _, s := range structs {
+		names[s.name] = true
+	}
+	out, err := newGenerator(names).generate(pkg, structs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+// the generated example package is checked in, and is also the golden file
+func TestGolden(t *testing.T) {
+	out := generateFile(t, "example/example.go")
+	golden := outputName("example/example.go")
+	if *update {
+		err := os.WriteFile(golden, out, 0666)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	expected, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out, expected) {
+		t.Errorf("generated code differs from %s; run go test -update", golden)
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	cases := []struct {
+		src string
+		err string
+	}{
+		{"type A struct {\n\tB\n}", "embedded field B"},
+		{"type A int", "not a struct"},
+		{"type A struct {\n\tB time.Time `msgpack:\",omitempty\"`\n}", "omitempty is not supported"},
+		{"type A struct {\n\tB int `msgpack:\"x\"`\n\tC int `msgpack:\"x\"`\n}", "duplicate key"},
+	}
+	for _, c := range cases {
+		src := "package p\n\n//mpack:generate\n" + c.src + "\n"
+		pkg, structs, err := parseFile("p.go", []byte(src))
+		if err == nil {
+			_, err = newGenerator(map[string]bool{"A": true}).generate(pkg, structs)
+		}
+		if err == nil || !strings.Contains(err.Error(), c.err) {
+			t.Errorf("%q: expected an error containing %q, got %v", c.src, c.err, err)
+		}
+	}
+}
+
+func TestUnannotated(t *testing.T) {
+	_, structs, err := parseFile("p.go", []byte("package p\n\n// A is not generated\ntype A struct {\n\tB int\n}\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(structs) != 0 {
+		t.Errorf("expected no structs, got %v", structs)
+	}
+}
@@ -0,0 +1,89 @@
+// mpackgen generates EncodeMsgpack, DecodeMsgpack and MsgpackSize methods
+// for struct types, so they can be packed and unpacked without reflection.
+//
+//	mpackgen file.go...
+//
+// Struct types whose declaration is preceded by a
+//
+//	//mpack:generate
+//
+// comment are generated for.  The methods for the types in foo.go are
+// written to foo_mpack.go.  All the files must be from the same package.
+//
+// Fields follow the same msgpack tag rules as Pack.  Basic types, []byte,
+// slices, maps with string keys and other generated types are handled
+// directly; fields of any other type are passed to the reflection
+// encoder.  Embedded fields are not supported.  Unlike Decode, generated
+// decoders match keys exactly and stop at the first error.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+type input struct {
+	filename string
+	pkg      string
+	structs  []structType
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: mpackgen file.go...\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	err := run(flag.Args())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "mpackgen: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(filenames []string) error {
+	// every file has to be parsed before any are generated, so that
+	// fields can refer to types in other files
+	var inputs []input
+	names := make(map[string]bool)
+	for _, filename := range filenames {
+		src, err := os.ReadFile(filename)
+		if err != nil {
+			return err
+		}
+		pkg, structs, err := parseFile(filename, src)
+		if err != nil {
+			return err
+		}
+		for _, s := range structs {
+			names[s.name] = true
+		}
+		inputs = append(inputs, input{filename, pkg, structs})
+	}
+
+	for _, in := range inputs {
+		if len(in.structs) == 0 {
+			continue
+		}
+		src, err := newGenerator(names).generate(in.pkg, in.structs)
+		if err != nil {
+			return fmt.Errorf("%s: %s", in.filename, err)
+		}
+		err = os.WriteFile(outputName(in.filename), src, 0666)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func outputName(filename string) string {
+	return strings.TrimSuffix(filename, ".go") + "_mpack.go"
+}
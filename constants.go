@@ -4,6 +4,12 @@ const (
 	type_nil             byte = 0xc0
 	type_false           byte = 0xc2
 	type_true            byte = 0xc3
+	type_bin8            byte = 0xc4
+	type_bin16           byte = 0xc5
+	type_bin32           byte = 0xc6
+	type_ext8            byte = 0xc7
+	type_ext16           byte = 0xc8
+	type_ext32           byte = 0xc9
 	type_float           byte = 0xca
 	type_double          byte = 0xcb
 	type_uint8           byte = 0xcc
@@ -14,8 +20,14 @@ const (
 	type_int16           byte = 0xd1
 	type_int32           byte = 0xd2
 	type_int64           byte = 0xd3
-	type_raw16           byte = 0xda
-	type_raw32           byte = 0xdb
+	type_fixext1         byte = 0xd4
+	type_fixext2         byte = 0xd5
+	type_fixext4         byte = 0xd6
+	type_fixext8         byte = 0xd7
+	type_fixext16        byte = 0xd8
+	type_str8            byte = 0xd9
+	type_raw16           byte = 0xda // str16 in the current spec
+	type_raw32           byte = 0xdb // str32 in the current spec
 	type_array16         byte = 0xdc
 	type_array32         byte = 0xdd
 	type_map16           byte = 0xde
@@ -0,0 +1,633 @@
+package mpack
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// Unmarshal unpacks a single value from data and stores it in the value
+// pointed to by v.  Struct fields follow the same msgpack tag rules as
+// Pack.
+func Unmarshal(data []byte, v interface{}) error {
+	return NewPackReader(bytes.NewReader(data)).Decode(v)
+}
+
+// Decode reads the next value and stores it in the value pointed to by v.
+// If part of the value doesn't fit the destination type, the rest is still
+// decoded and the first *UnmarshalTypeError is returned.
+func (pr *PackReader) Decode(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return &InvalidUnmarshalError{reflect.TypeOf(v)}
+	}
+	d := &decoder{pr: pr}
+	err := d.value(rv.Elem())
+	if err != nil {
+		return err
+	}
+	return d.savedError
+}
+
+// decodes straight from the token stream into Go values
+type decoder struct {
+	pr *PackReader
+
+	// the first type error; decoding carries on past it so the reader
+	// stays at a value boundary
+	savedError error
+}
+
+func (d *decoder) saveError(err error) {
+	if d.savedError == nil {
+		d.savedError = err
+	}
+}
+
+var unmarshalerType = reflect.TypeOf((*MsgpackUnmarshaler)(nil)).Elem()
+
+// find a MsgpackUnmarshaler for v, allocating a nil pointer if needed.
+// a nil interface has nothing to unmarshal into, so is left to the
+// reflection path to refuse.
+func unmarshaler(v reflect.Value) (MsgpackUnmarshaler, bool) {
+	if v.Kind() == reflect.Interface && v.IsNil() {
+		return nil, false
+	}
+	if v.Type().Implements(unmarshalerType) {
+		if v.Kind() == reflect.Ptr && v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return v.Interface().(MsgpackUnmarshaler), true
+	}
+	if v.CanAddr() && v.Addr().Type().Implements(unmarshalerType) {
+		return v.Addr().Interface().(MsgpackUnmarshaler), true
+	}
+	return nil, false
+}
+
+// the generated decoder for v, if it has one, allocating a nil pointer
+// if needed.  a pointer is left to the reflection path if the next value
+// is nil, or the reader can't tell without reading it.
+func (d *decoder) staticDecoder(v reflect.Value) (MsgpackDecoder, bool) {
+	if v.Kind() != reflect.Ptr {
+		if v.CanAddr() && v.Addr().Type().Implements(decoderType) {
+			return v.Addr().Interface().(MsgpackDecoder), true
+		}
+		return nil, false
+	}
+	if !v.Type().Implements(decoderType) {
+		return nil, false
+	}
+	if isNil, ok := d.pr.nextIsNil(); !ok || isNil {
+		return nil, false
+	}
+	if v.IsNil() {
+		v.Set(reflect.New(v.Type().Elem()))
+	}
+	return v.Interface().(MsgpackDecoder), true
+}
+
+func (d *decoder) value(v reflect.Value) error {
+	if dec, ok := d.staticDecoder(v); ok {
+		if d.pr.depth == 0 {
+			d.pr.consumed = 0
+		}
+		start, depth := d.pr.offset, d.pr.depth
+		err := d.pr.enter()
+		if err == nil {
+			err = dec.DecodeMsgpack(d.pr)
+		}
+		// a decoder that gave up may not have left everything it entered
+		d.pr.depth = depth
+		if d.pr.offset > start {
+			err = unexpectedEOF(err)
+		}
+		return err
+	}
+	if u, ok := unmarshaler(v); ok {
+		raw, err := d.pr.readRawValue()
+		if err != nil {
+			return err
+		}
+		if v.Kind() == reflect.Ptr && len(raw) == 1 && raw[0] == type_nil {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		return u.UnmarshalMsgpack(raw)
+	}
+
+	tok, err := d.pr.NextToken()
+	if err != nil {
+		return err
+	}
+	return d.token(tok, v)
+}
+
+// store the value that starts with tok in v
+func (d *decoder) token(tok Token, v reflect.Value) error {
+	switch tok.Kind {
+	case ArrayToken:
+		return d.array(tok.Len, v)
+	case MapToken:
+		return d.mapping(tok.Len, v)
+	}
+	err := assign(v, tok.Value)
+	if err != nil {
+		d.saveError(err)
+	}
+	return nil
+}
+
+// follow pointers down to the value to fill in, allocating as needed
+func indirect(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+func isEmptyInterface(v reflect.Value) bool {
+	return v.Kind() == reflect.Interface && v.NumMethod() == 0
+}
+
+// the elements of a value that can't be stored are skipped
+func (d *decoder) skipElements(count int) error {
+	for i := 0; i < count; i++ {
+		err := d.pr.Skip()
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+	}
+	return nil
+}
+
+func (d *decoder) array(length int, v reflect.Value) error {
+	v = indirect(v)
+	if isEmptyInterface(v) {
+		generic, err := d.pr.unpackArray(length)
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		v.Set(reflect.ValueOf(generic))
+		return nil
+	}
+
+	err := d.pr.enter()
+	defer d.pr.leave()
+	if err != nil {
+		return err
+	}
+
+	switch v.Kind() {
+	case reflect.Slice:
+		if set := d.typedSlice(length, v); set != nil {
+			return d.elements(length, v, set)
+		}
+		s := reflect.MakeSlice(v.Type(), 0, preallocLength(length))
+		zero := reflect.Zero(v.Type().Elem())
+		for i := 0; i < length; i++ {
+			s = reflect.Append(s, zero)
+			err := d.value(s.Index(i))
+			if err != nil {
+				return unexpectedEOF(err)
+			}
+		}
+		v.Set(s)
+	case reflect.Array:
+		for i := 0; i < length; i++ {
+			if i >= v.Len() {
+				return d.skipElements(length - i)
+			}
+			err := d.value(v.Index(i))
+			if err != nil {
+				return unexpectedEOF(err)
+			}
+		}
+		for i := length; i < v.Len(); i++ {
+			v.Index(i).Set(reflect.Zero(v.Type().Elem()))
+		}
+	default:
+		d.saveError(&UnmarshalTypeError{Value: "array", Type: v.Type()})
+		return d.skipElements(length)
+	}
+	return nil
+}
+
+// for the common slice types, a new slice for v and a function that adds
+// element i, storing a scalar token in it without reflection and reporting
+// whether it could.  nil for any other type.
+func (d *decoder) typedSlice(length int, v reflect.Value) func(i int, tok Token) bool {
+	if !v.CanAddr() {
+		return nil
+	}
+	switch p := v.Addr().Interface().(type) {
+	case *[]int:
+		s := make([]int, 0, preallocLength(length))
+		*p = s
+		return func(i int, tok Token) bool {
+			n, ok := tokenInt64(tok.Value)
+			s = append(s, int(n))
+			*p = s
+			return ok && int64(s[i]) == n
+		}
+	case *[]int32:
+		s := make([]int32, 0, preallocLength(length))
+		*p = s
+		return func(i int, tok Token) bool {
+			n, ok := tokenInt64(tok.Value)
+			s = append(s, int32(n))
+			*p = s
+			return ok && int64(s[i]) == n
+		}
+	case *[]int64:
+		s := make([]int64, 0, preallocLength(length))
+		*p = s
+		return func(i int, tok Token) bool {
+			n, ok := tokenInt64(tok.Value)
+			s = append(s, n)
+			*p = s
+			return ok
+		}
+	case *[]uint64:
+		s := make([]uint64, 0, preallocLength(length))
+		*p = s
+		return func(i int, tok Token) bool {
+			n, ok := tokenUint64(tok.Value)
+			s = append(s, n)
+			*p = s
+			return ok
+		}
+	case *[]float32:
+		s := make([]float32, 0, preallocLength(length))
+		*p = s
+		return func(i int, tok Token) bool {
+			f, ok := tokenFloat64(tok.Value)
+			s = append(s, float32(f))
+			*p = s
+			return ok
+		}
+	case *[]float64:
+		s := make([]float64, 0, preallocLength(length))
+		*p = s
+		return func(i int, tok Token) bool {
+			f, ok := tokenFloat64(tok.Value)
+			s = append(s, f)
+			*p = s
+			return ok
+		}
+	case *[]string:
+		s := make([]string, 0, preallocLength(length))
+		*p = s
+		return func(i int, tok Token) bool {
+			str, ok := tok.Value.(string)
+			s = append(s, str)
+			*p = s
+			return ok
+		}
+	case *[]bool:
+		s := make([]bool, 0, preallocLength(length))
+		*p = s
+		return func(i int, tok Token) bool {
+			b, ok := tok.Value.(bool)
+			s = append(s, b)
+			*p = s
+			return ok
+		}
+	}
+	return nil
+}
+
+// read length elements into slice v using set, falling back to the
+// reflection path for anything set can't store so that conversions and
+// type errors work the same
+func (d *decoder) elements(length int, v reflect.Value, set func(int, Token) bool) error {
+	for i := 0; i < length; i++ {
+		tok, err := d.pr.NextToken()
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		// set adds element i even when it can't store tok
+		if set(i, tok) && tok.Kind != ArrayToken && tok.Kind != MapToken {
+			continue
+		}
+		elt := v.Index(i)
+		elt.Set(reflect.Zero(elt.Type()))
+		err = d.token(tok, elt)
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+	}
+	return nil
+}
+
+// map[string]string and map[string]interface{} without reflection for
+// each entry.  ok is false for other types.
+func (d *decoder) typedMap(length int, v reflect.Value) (ok bool, err error) {
+	if !v.CanAddr() {
+		return false, nil
+	}
+	switch p := v.Addr().Interface().(type) {
+	case *map[string]string:
+		if *p == nil {
+			*p = make(map[string]string)
+		}
+		m := *p
+		for i := 0; i < length; i++ {
+			k, err := d.stringKey()
+			if err != nil {
+				return true, err
+			}
+			tok, err := d.pr.NextToken()
+			if err != nil {
+				return true, unexpectedEOF(err)
+			}
+			s, ok := tok.Value.(string)
+			if !ok || tok.Kind != StringToken {
+				e := reflect.New(reflect.TypeOf("")).Elem()
+				err = d.token(tok, e)
+				if err != nil {
+					return true, unexpectedEOF(err)
+				}
+				s = e.String()
+			}
+			m[k] = s
+		}
+		return true, nil
+	case *map[string]interface{}:
+		if *p == nil {
+			*p = make(map[string]interface{})
+		}
+		m := *p
+		for i := 0; i < length; i++ {
+			k, err := d.stringKey()
+			if err != nil {
+				return true, err
+			}
+			value, err := d.pr.unpackValue()
+			if err != nil {
+				return true, unexpectedEOF(err)
+			}
+			m[k] = value
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// a map key that should be a string
+func (d *decoder) stringKey() (string, error) {
+	tok, err := d.pr.NextToken()
+	if err != nil {
+		return "", unexpectedEOF(err)
+	}
+	if s, ok := tok.Value.(string); ok {
+		return s, nil
+	}
+	k := reflect.New(reflect.TypeOf("")).Elem()
+	err = d.token(tok, k)
+	return k.String(), unexpectedEOF(err)
+}
+
+func (d *decoder) mapping(length int, v reflect.Value) error {
+	v = indirect(v)
+	if isEmptyInterface(v) {
+		generic, err := d.pr.unpackMap(length)
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		v.Set(reflect.ValueOf(generic))
+		return nil
+	}
+
+	err := d.pr.enter()
+	defer d.pr.leave()
+	if err != nil {
+		return err
+	}
+
+	if ok, err := d.typedMap(length, v); ok {
+		return err
+	}
+
+	switch v.Kind() {
+	case reflect.Map:
+		t := v.Type()
+		if v.IsNil() {
+			v.Set(reflect.MakeMap(t))
+		}
+		for i := 0; i < length; i++ {
+			offset := d.pr.offset
+			k := reflect.New(t.Key()).Elem()
+			err := d.value(k)
+			if err != nil {
+				return unexpectedEOF(err)
+			}
+			e := reflect.New(t.Elem()).Elem()
+			err = d.value(e)
+			if err != nil {
+				return unexpectedEOF(err)
+			}
+			if !k.Comparable() {
+				kt := k.Type()
+				if k.Kind() == reflect.Interface {
+					kt = k.Elem().Type()
+				}
+				d.saveError(&UnhashableKeyError{kt, offset})
+				continue
+			}
+			v.SetMapIndex(k, e)
+		}
+	case reflect.Struct:
+		fields := cachedFields(v.Type())
+		for i := 0; i < length; i++ {
+			err := d.structField(v, fields)
+			if err != nil {
+				return unexpectedEOF(err)
+			}
+		}
+	default:
+		d.saveError(&UnmarshalTypeError{Value: "map", Type: v.Type()})
+		return d.skipElements(2 * length)
+	}
+	return nil
+}
+
+// decode one key/value pair into the matching field of struct v
+func (d *decoder) structField(v reflect.Value, fields []field) error {
+	var key interface{}
+	err := d.value(reflect.ValueOf(&key).Elem())
+	if err != nil {
+		return err
+	}
+	name, ok := key.(string)
+	if !ok {
+		return d.pr.Skip()
+	}
+	f, ok := lookupField(fields, name)
+	if !ok {
+		return d.pr.Skip()
+	}
+	fv, ok := fieldByIndexAlloc(v, f.index)
+	if !ok {
+		return d.pr.Skip()
+	}
+
+	saved := d.savedError
+	err = d.value(fv)
+	if e, ok := d.savedError.(*UnmarshalTypeError); ok && saved == nil {
+		if e.Field == "" {
+			e.Field = f.name
+		} else {
+			e.Field = f.name + "." + e.Field
+		}
+	}
+	return err
+}
+
+// name of an unpacked value for error messages
+func describe(generic interface{}) string {
+	switch generic.(type) {
+	case nil:
+		return "nil"
+	case []byte:
+		return "bin"
+	case []interface{}:
+		return "array"
+	case map[interface{}]interface{}:
+		return "map"
+	}
+	return reflect.TypeOf(generic).String()
+}
+
+func typeError(generic interface{}, t reflect.Type) error {
+	return &UnmarshalTypeError{Value: describe(generic), Type: t}
+}
+
+// assign stores a scalar token value in v, converting as needed
+func assign(v reflect.Value, generic interface{}) error {
+	if generic == nil {
+		switch v.Kind() {
+		case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+			v.Set(reflect.Zero(v.Type()))
+		}
+		return nil
+	}
+
+	g := reflect.ValueOf(generic)
+	if g.Type().AssignableTo(v.Type()) {
+		v.Set(g)
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return assign(v.Elem(), generic)
+	case reflect.Bool:
+		b, ok := generic.(bool)
+		if !ok {
+			return typeError(generic, v.Type())
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var n int64
+		if isInt(g) {
+			n = g.Int()
+		} else if isUint(g) && g.Uint() <= math.MaxInt64 {
+			n = int64(g.Uint())
+		} else {
+			return typeError(generic, v.Type())
+		}
+		if v.OverflowInt(n) {
+			return typeError(generic, v.Type())
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		var n uint64
+		if isUint(g) {
+			n = g.Uint()
+		} else if isInt(g) && g.Int() >= 0 {
+			n = uint64(g.Int())
+		} else {
+			return typeError(generic, v.Type())
+		}
+		if v.OverflowUint(n) {
+			return typeError(generic, v.Type())
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		switch {
+		case isFloat(g):
+			v.SetFloat(g.Float())
+		case isInt(g):
+			v.SetFloat(float64(g.Int()))
+		case isUint(g):
+			v.SetFloat(float64(g.Uint()))
+		default:
+			return typeError(generic, v.Type())
+		}
+	case reflect.String:
+		switch s := generic.(type) {
+		case string:
+			v.SetString(s)
+		case []byte:
+			v.SetString(string(s))
+		default:
+			return typeError(generic, v.Type())
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.Uint8 {
+			return typeError(generic, v.Type())
+		}
+		switch b := generic.(type) {
+		case []byte:
+			v.SetBytes(b)
+		case string:
+			v.SetBytes([]byte(b))
+		default:
+			return typeError(generic, v.Type())
+		}
+	default:
+		return typeError(generic, v.Type())
+	}
+	return nil
+}
+
+// exact match first, then case-insensitive
+func lookupField(fields []field, name string) (field, bool) {
+	for _, f := range fields {
+		if f.name == name {
+			return f, true
+		}
+	}
+	for _, f := range fields {
+		if strings.EqualFold(f.name, name) {
+			return f, true
+		}
+	}
+	return field{}, false
+}
+
+// like fieldByIndex, but allocates nil embedded pointers on the way.
+// ok is false if one of them is unexported and can't be set.
+func fieldByIndexAlloc(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if !v.CanSet() {
+					return reflect.Value{}, false
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
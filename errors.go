@@ -0,0 +1,127 @@
+package mpack
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// returned by Decode and Unmarshal when v is not a non-nil pointer
+type InvalidUnmarshalError struct {
+	Type reflect.Type
+}
+
+func (e *InvalidUnmarshalError) Error() string {
+	if e.Type == nil {
+		return "mpack: Unmarshal(nil)"
+	}
+	if e.Type.Kind() != reflect.Ptr {
+		return "mpack: Unmarshal(non-pointer " + e.Type.String() + ")"
+	}
+	return "mpack: Unmarshal(nil " + e.Type.String() + ")"
+}
+
+// returned when a packed value can't be stored in the destination type.
+// Field is the dotted path of the struct field, if any.
+type UnmarshalTypeError struct {
+	Value string
+	Type  reflect.Type
+	Field string
+}
+
+func (e *UnmarshalTypeError) Error() string {
+	if e.Field != "" {
+		return "mpack: cannot unmarshal " + e.Value + " into Go struct field " + e.Field + " of type " + e.Type.String()
+	}
+	return "mpack: cannot unmarshal " + e.Value + " into Go value of type " + e.Type.String()
+}
+
+// returned when a value exceeds one of the DecoderOptions limits
+type LimitError struct {
+	Limit string
+	Max   int
+	Value uint64
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("mpack: %s %d exceeds limit of %d", e.Limit, e.Value, e.Max)
+}
+
+// returned by Pack for values it has no encoding for
+type UnsupportedTypeError struct {
+	Type reflect.Type
+}
+
+func (e *UnsupportedTypeError) Error() string {
+	return "mpack: unsupported type: " + e.Type.String()
+}
+
+// returned by Unpack for a byte that doesn't start any msgpack type.
+// Offset is the position of the byte in the reader.
+type InvalidPrefixError struct {
+	Prefix byte
+	Offset int
+}
+
+func (e *InvalidPrefixError) Error() string {
+	return fmt.Sprintf("mpack: invalid type prefix 0x%02x at offset %d", e.Prefix, e.Offset)
+}
+
+// returned by Unpack and Decode for a map key that can't be the key of a
+// Go map: an array, a map, or an ext value with no registered type.
+// Offset is the position of the key in the reader.
+type UnhashableKeyError struct {
+	Type   reflect.Type
+	Offset int
+}
+
+func (e *UnhashableKeyError) Error() string {
+	return fmt.Sprintf("mpack: unhashable map key of type %s at offset %d", e.Type, e.Offset)
+}
+
+// returned by Validate for the first problem in its input.  Offset is
+// the position of the value at fault, and Err is what is wrong with it:
+// an *InvalidPrefixError, a *LimitError, io.ErrUnexpectedEOF if it is
+// truncated, ErrTrailingData, or the error from decoding an ext value of
+// a reserved type, like a timestamp of the wrong length.
+type ValidationError struct {
+	Offset int
+	Err    error
+}
+
+func (e *ValidationError) Error() string {
+	// the errors it wraps mostly have their own prefix
+	return fmt.Sprintf("mpack: invalid data at offset %d: %s", e.Offset, strings.TrimPrefix(e.Err.Error(), "mpack: "))
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// returned by Pack for a value that contains itself, or that is nested
+// deeper than the PackWriter's MaxDepth.  Path leads from the top of the
+// value to where it happened, like .Items[2]["next"], and Err is ErrCycle
+// or a *LimitError.
+type EncodeError struct {
+	Path string
+	Err  error
+
+	// while an ErrCycle is on its way out (see trimCycle): how many
+	// levels go round the cycle, the value at each level and the length
+	// of the step it added to Path, and how much of Path is accounted for
+	cycle   int
+	keys    []activeKey
+	steps   []int
+	unwound int
+}
+
+func (e *EncodeError) Error() string {
+	if e.Path == "" {
+		return e.Err.Error()
+	}
+	return e.Err.Error() + " at " + e.Path
+}
+
+func (e *EncodeError) Unwrap() error {
+	return e.Err
+}
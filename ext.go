@@ -0,0 +1,85 @@
+package mpack
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+)
+
+// an ext value whose type code has no registered decoder
+type Ext struct {
+	Type int8
+	Data []byte
+}
+
+// converts a value of the registered type to the ext payload
+type ExtEncoder func(value interface{}) ([]byte, error)
+
+// converts an ext payload back to a value of the registered type
+type ExtDecoder func(data []byte) (interface{}, error)
+
+type extType struct {
+	code   int8
+	typ    reflect.Type
+	encode ExtEncoder
+	decode ExtDecoder
+}
+
+// the registered types, which can be added to while values are being
+// packed and unpacked
+var extRegistry struct {
+	sync.RWMutex
+	byCode map[int8]*extType
+	byType map[reflect.Type]*extType
+}
+
+// RegisterExt maps an application ext type code (0 to 127) to the Go
+// type of value.  Values of that type are packed as ext values using
+// encode, and ext values with that code are unpacked using decode.
+func RegisterExt(code int8, value interface{}, encode ExtEncoder, decode ExtDecoder) error {
+	if code < 0 {
+		return errors.New("mpack: negative ext type codes are reserved")
+	}
+	return registerExt(code, reflect.TypeOf(value), encode, decode)
+}
+
+func registerExt(code int8, typ reflect.Type, encode ExtEncoder, decode ExtDecoder) error {
+	if typ == nil || encode == nil || decode == nil {
+		return errors.New("mpack: ext registration needs a type, an encoder and a decoder")
+	}
+	extRegistry.Lock()
+	defer extRegistry.Unlock()
+	if _, present := extRegistry.byCode[code]; present {
+		return errors.New("mpack: ext type code already registered")
+	}
+	if _, present := extRegistry.byType[typ]; present {
+		return errors.New("mpack: ext type already registered for " + typ.String())
+	}
+	if extRegistry.byCode == nil {
+		extRegistry.byCode = make(map[int8]*extType)
+		extRegistry.byType = make(map[reflect.Type]*extType)
+	}
+	ext := &extType{code: code, typ: typ, encode: encode, decode: decode}
+	extRegistry.byCode[code] = ext
+	extRegistry.byType[typ] = ext
+	return nil
+}
+
+// the ext type registered for values of type t, if any
+func extForType(t reflect.Type) (*extType, bool) {
+	extRegistry.RLock()
+	ext, present := extRegistry.byType[t]
+	extRegistry.RUnlock()
+	return ext, present
+}
+
+// turn an ext payload into the registered type, or an Ext if there is none
+func decodeExt(code int8, data []byte) (interface{}, error) {
+	extRegistry.RLock()
+	ext, present := extRegistry.byCode[code]
+	extRegistry.RUnlock()
+	if !present {
+		return Ext{Type: code, Data: data}, nil
+	}
+	return ext.decode(data)
+}
@@ -0,0 +1,152 @@
+package mpack
+
+import (
+	"bytes"
+	"errors"
+	"io"
+)
+
+// returned by Extract when the path doesn't lead to a value
+var ErrNotFound = errors.New("mpack: path not found")
+
+// Extract unpacks only the value at path in data.  An int in the path
+// indexes an array; anything else is a map key, compared by value so
+// that a key of 1 matches however the number was packed.  Everything
+// off the path is skipped without being decoded.
+func Extract(data []byte, path ...interface{}) (interface{}, error) {
+	pr := NewPackReader(bytes.NewReader(data))
+	var scratch []byte
+	for _, key := range path {
+		found, err := pr.find(key, &scratch)
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		if !found {
+			return nil, ErrNotFound
+		}
+	}
+	value, err := pr.unpackValue()
+	return value, unexpectedEOF(err)
+}
+
+// move to the element of the next value addressed by key
+func (pr *PackReader) find(key interface{}, scratch *[]byte) (bool, error) {
+	b, err := pr.ReadByte()
+	if err != nil {
+		return false, err
+	}
+	tok, err := pr.readHeader(b)
+	if err != nil {
+		return false, err
+	}
+	err = pr.enter()
+	if err != nil {
+		return false, err
+	}
+
+	switch tok.Kind {
+	case ArrayToken:
+		index, ok := key.(int)
+		if !ok || index < 0 || index >= tok.Len {
+			return false, nil
+		}
+		for i := 0; i < index; i++ {
+			err := pr.Skip()
+			if err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	case MapToken:
+		if n, ok := key.(int); ok {
+			key = int64(n)
+		}
+		for i := 0; i < tok.Len; i++ {
+			match, err := pr.matchKey(key, scratch)
+			if err != nil || match {
+				return match, err
+			}
+			err = pr.Skip()
+			if err != nil {
+				return false, err
+			}
+		}
+	}
+	return false, nil
+}
+
+// read a map key and compare it with key.  strings are compared in
+// place, without being allocated.
+func (pr *PackReader) matchKey(key interface{}, scratch *[]byte) (bool, error) {
+	b, err := pr.ReadByte()
+	if err != nil {
+		return false, err
+	}
+	tok, err := pr.readHeader(b)
+	if err != nil {
+		return false, err
+	}
+
+	switch tok.Kind {
+	case StringToken, BinToken:
+		s, ok := key.(string)
+		if !ok || len(s) != tok.Len {
+			_, err := io.CopyN(io.Discard, pr, int64(tok.Len))
+			return false, err
+		}
+		if cap(*scratch) < tok.Len {
+			*scratch = make([]byte, tok.Len)
+		}
+		buf := (*scratch)[:tok.Len]
+		_, err := io.ReadFull(pr, buf)
+		return string(buf) == s, err
+	case ExtToken:
+		_, err := io.CopyN(io.Discard, pr, int64(tok.Len))
+		return false, err
+	case ArrayToken, MapToken:
+		count := tok.Len
+		if tok.Kind == MapToken {
+			count *= 2
+		}
+		err := pr.enter()
+		defer pr.leave()
+		if err != nil {
+			return false, err
+		}
+		for i := 0; i < count; i++ {
+			err := pr.Skip()
+			if err != nil {
+				return false, err
+			}
+		}
+		return false, nil
+	}
+	return scalarEqual(key, tok.Value), nil
+}
+
+// numbers are equal if they have the same value, whatever their type
+func scalarEqual(a, b interface{}) bool {
+	if x, ok := tokenInt64(a); ok {
+		y, ok := tokenInt64(b)
+		return ok && x == y
+	}
+	if x, ok := tokenUint64(a); ok {
+		y, ok := tokenUint64(b)
+		return ok && x == y
+	}
+	if x, ok := tokenFloat64(a); ok {
+		y, ok := tokenFloat64(b)
+		return ok && x == y
+	}
+	return a == b
+}
+
+func tokenFloat64(generic interface{}) (float64, bool) {
+	switch f := generic.(type) {
+	case float32:
+		return float64(f), true
+	case float64:
+		return f, true
+	}
+	return 0, false
+}
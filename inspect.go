@@ -0,0 +1,200 @@
+package mpack
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+var typeNames = map[byte]string{
+	type_nil:      "nil",
+	type_false:    "false",
+	type_true:     "true",
+	type_bin8:     "bin8",
+	type_bin16:    "bin16",
+	type_bin32:    "bin32",
+	type_ext8:     "ext8",
+	type_ext16:    "ext16",
+	type_ext32:    "ext32",
+	type_float:    "float",
+	type_double:   "double",
+	type_uint8:    "uint8",
+	type_uint16:   "uint16",
+	type_uint32:   "uint32",
+	type_uint64:   "uint64",
+	type_int8:     "int8",
+	type_int16:    "int16",
+	type_int32:    "int32",
+	type_int64:    "int64",
+	type_fixext1:  "fixext1",
+	type_fixext2:  "fixext2",
+	type_fixext4:  "fixext4",
+	type_fixext8:  "fixext8",
+	type_fixext16: "fixext16",
+	type_str8:     "str8",
+	type_raw16:    "raw16",
+	type_raw32:    "raw32",
+	type_array16:  "array16",
+	type_array32:  "array32",
+	type_map16:    "map16",
+	type_map32:    "map32",
+}
+
+// the name of the type a prefix byte starts
+func typeName(b byte) string {
+	switch {
+	case b <= positive_fix_max:
+		return "positive_fix"
+	case b >= negative_fix_min:
+		return "negative_fix"
+	case b >= type_fix_raw && b <= type_fix_raw_max:
+		return "fix_raw"
+	case b >= type_fix_array_min && b <= type_fix_array_max:
+		return "fix_array"
+	case b >= type_fix_map_min && b <= type_fix_map_max:
+		return "fix_map"
+	}
+	if name, present := typeNames[b]; present {
+		return name
+	}
+	return "invalid"
+}
+
+// longest string or bin payload shown in full
+const inspectPreview = 32
+
+type inspector struct {
+	pr *PackReader
+	w  io.Writer
+
+	// the first error writing to w
+	err error
+}
+
+// Inspect writes an annotated dump of the values in r to w: one line per
+// value with its offset, prefix byte, type name and contents, indented to
+// show nesting.  Reading stops at the first invalid or truncated value,
+// which is marked with "!!" and the offset of the byte at fault, and its
+// error is returned.  r is read with the DefaultDecoderOptions limits.
+func Inspect(r io.Reader, w io.Writer) error {
+	ins := &inspector{pr: NewPackReaderOptions(r, DefaultDecoderOptions), w: w}
+	for {
+		ins.pr.consumed = 0
+		err := ins.value(0)
+		if err == io.EOF {
+			return ins.err
+		}
+		if err != nil {
+			return err
+		}
+		if ins.err != nil {
+			return ins.err
+		}
+	}
+}
+
+func (ins *inspector) printf(format string, args ...interface{}) {
+	if ins.err == nil {
+		_, ins.err = fmt.Fprintf(ins.w, format, args...)
+	}
+}
+
+// mark where reading failed
+func (ins *inspector) fail(offset int, err error, name string, start int) error {
+	err = unexpectedEOF(err)
+	switch e := err.(type) {
+	case *InvalidPrefixError:
+		ins.printf("%08x  !!  invalid prefix 0x%02x\n", e.Offset, e.Prefix)
+		return err
+	case *LimitError:
+		ins.printf("%08x  !!  %s in %s at %08x\n", offset, e.Error(), name, start)
+		return err
+	}
+	if err == io.ErrUnexpectedEOF {
+		ins.printf("%08x  !!  truncated %s at %08x\n", offset, name, start)
+	} else {
+		ins.printf("%08x  !!  %s\n", offset, err)
+	}
+	return err
+}
+
+func (ins *inspector) value(depth int) error {
+	pr := ins.pr
+	start := pr.offset
+	b, err := pr.ReadByte()
+	if err != nil {
+		if err == io.EOF && depth == 0 {
+			return err
+		}
+		return ins.fail(pr.offset, err, "value", start)
+	}
+	name := typeName(b)
+	tok, err := pr.readHeader(b)
+	if err != nil {
+		return ins.fail(pr.offset, err, name, start)
+	}
+
+	var detail string
+	switch tok.Kind {
+	case NilToken, BoolToken:
+	case StringToken, BinToken, ExtToken:
+		// only the preview is kept
+		data := make([]byte, min(tok.Len, inspectPreview))
+		_, err := io.ReadFull(pr, data)
+		if err == nil {
+			_, err = io.CopyN(io.Discard, pr, int64(tok.Len-len(data)))
+		}
+		if err != nil {
+			return ins.fail(pr.offset, err, name, start)
+		}
+		detail = fmt.Sprintf("len=%d", tok.Len)
+		if tok.Kind == ExtToken {
+			detail = fmt.Sprintf("type=%d %s", tok.ExtType, detail)
+		}
+		if tok.Len > 0 {
+			detail += " " + preview(tok.Kind, data, tok.Len)
+		}
+	case ArrayToken, MapToken:
+		detail = fmt.Sprintf("len=%d", tok.Len)
+	default:
+		detail = fmt.Sprintf("%v", tok.Value)
+	}
+	if detail != "" {
+		detail = " " + detail
+	}
+	ins.printf("%08x  %02x  %s%s%s\n", start, b, strings.Repeat("  ", depth), name, detail)
+
+	count := tok.Len
+	switch tok.Kind {
+	case ArrayToken:
+	case MapToken:
+		count *= 2
+	default:
+		return nil
+	}
+	err = pr.enter()
+	defer pr.leave()
+	if err != nil {
+		return ins.fail(pr.offset, err, name, start)
+	}
+	for i := 0; i < count; i++ {
+		err := ins.value(depth + 1)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// strings are quoted, bin and ext data is shown in hex.  data is the
+// start of a payload of length bytes.
+func preview(kind TokenKind, data []byte, length int) string {
+	more := ""
+	if length > len(data) {
+		more = "..."
+	}
+	if kind == StringToken {
+		return fmt.Sprintf("%q%s", data, more)
+	}
+	return fmt.Sprintf("%x%s", data, more)
+}
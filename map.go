@@ -3,6 +3,7 @@ package mpack
 import (
 	"log"
 	"reflect"
+	"time"
 )
 
 type Map struct {
@@ -132,6 +133,9 @@ func (m Map) StringIndex(key interface{}) (string, bool) {
 	if !present {
 		return "", false
 	}
+	if s, ok := index.(string); ok {
+		return s, true
+	}
 	v := reflect.ValueOf(index)
 	if v.IsValid() == false {
 		return "", true
@@ -142,6 +146,15 @@ func (m Map) StringIndex(key interface{}) (string, bool) {
 	return string(index.([]uint8)), true
 }
 
+func (m Map) TimeIndex(key interface{}) (time.Time, bool) {
+	index, present := m.raw[key]
+	if !present {
+		return time.Time{}, false
+	}
+	t, ok := index.(time.Time)
+	return t, ok
+}
+
 func (m Map) ArrayIndex(key interface{}) (*Array, bool) {
 	index, present := m.raw[key]
 	if !present {
@@ -167,6 +180,6 @@ func (m Map) MapIndex(key interface{}) (*Map, bool) {
 
 func (m Map) DumpKeys() {
 	for k := range m.raw {
-		log.Printf(k.(string))
+		log.Printf("%v", k)
 	}
 }
@@ -0,0 +1,80 @@
+package mpack
+
+import "reflect"
+
+// implemented by types that pack themselves.  MarshalMsgpack returns a
+// single complete packed value, which is written as is.
+type MsgpackMarshaler interface {
+	MarshalMsgpack() ([]byte, error)
+}
+
+// implemented by types that unpack themselves.  UnmarshalMsgpack is given
+// the exact bytes of a single packed value and must copy them if it wants
+// to keep them.
+type MsgpackUnmarshaler interface {
+	UnmarshalMsgpack(data []byte) error
+}
+
+var marshalerType = reflect.TypeOf((*MsgpackMarshaler)(nil)).Elem()
+
+// find a MsgpackMarshaler for value.  values whose pointer type has the
+// method are copied so it can be called on them.
+func marshaler(value interface{}) (MsgpackMarshaler, bool) {
+	v := reflect.ValueOf(value)
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		return nil, false
+	}
+	if m, ok := value.(MsgpackMarshaler); ok {
+		return m, true
+	}
+	if v.Kind() != reflect.Ptr && reflect.PtrTo(v.Type()).Implements(marshalerType) {
+		p := reflect.New(v.Type())
+		p.Elem().Set(v)
+		return p.Interface().(MsgpackMarshaler), true
+	}
+	return nil, false
+}
+
+// implemented by types with generated encoding methods (see
+// cmd/mpackgen), which are used instead of reflection.  EncodeMsgpack
+// writes a single value and returns the number of bytes written.
+type MsgpackEncoder interface {
+	EncodeMsgpack(pw *PackWriter) (int, error)
+}
+
+// the decoding side of MsgpackEncoder.  DecodeMsgpack reads a single
+// value, stopping at the first error.
+type MsgpackDecoder interface {
+	DecodeMsgpack(pr *PackReader) error
+}
+
+var decoderType = reflect.TypeOf((*MsgpackDecoder)(nil)).Elem()
+
+func encoder(value interface{}) (MsgpackEncoder, bool) {
+	e, ok := value.(MsgpackEncoder)
+	if !ok {
+		return nil, false
+	}
+	v := reflect.ValueOf(value)
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		return nil, false
+	}
+	return e, true
+}
+
+// RawMessage is a single packed value, kept as is.  It can be used to
+// put off decoding part of a value, or to pass it on without decoding it
+// at all.  A nil RawMessage packs as nil.
+type RawMessage []byte
+
+func (m RawMessage) MarshalMsgpack() ([]byte, error) {
+	if len(m) == 0 {
+		return []byte{type_nil}, nil
+	}
+	return m, nil
+}
+
+func (m *RawMessage) UnmarshalMsgpack(data []byte) error {
+	*m = append((*m)[0:0], data...)
+	return nil
+}
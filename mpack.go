@@ -17,7 +17,46 @@ func Pack(w io.Writer, value interface{}) (packedBytes int, err error) {
 	return n, e
 }
 
+// Unpack reads one value from reader and nothing after it, so it can be
+// called again for the next value.  Readers that aren't a FullReader are
+// read a byte at a time for headers, which is slow on an unbuffered
+// connection; a PackReader is the better choice for a stream of values.
 func Unpack(reader io.Reader) (interface{}, int, error) {
-	pr := NewPackReader(reader)
-	return pr.unpack()
+	fr, ok := reader.(FullReader)
+	if !ok {
+		fr = &byteAtATimeReader{r: reader}
+	}
+	return NewPackReader(fr).unpack()
+}
+
+// a FullReader that reads no further than it is asked to
+type byteAtATimeReader struct {
+	r   io.Reader
+	buf [1]byte
+}
+
+func (br *byteAtATimeReader) ReadByte() (byte, error) {
+	_, err := io.ReadFull(br.r, br.buf[:])
+	return br.buf[0], err
+}
+
+func (br *byteAtATimeReader) Read(p []byte) (int, error) {
+	return br.r.Read(p)
+}
+
+// UnpackBytes unpacks the value at the start of b and returns it with the
+// rest of b, so concatenated values can be unpacked one after the other.
+func UnpackBytes(b []byte) (value interface{}, rest []byte, err error) {
+	return UnpackBytesOptions(b, DecoderOptions{})
+}
+
+// like UnpackBytes, but with limits and options.  With AliasBytes set, bin
+// values share memory with b.  On error rest is b.
+func UnpackBytesOptions(b []byte, options DecoderOptions) (value interface{}, rest []byte, err error) {
+	sr := &sliceReader{data: b}
+	value, _, err = NewPackReaderOptions(sr, options).unpack()
+	if err != nil {
+		return nil, b, err
+	}
+	return value, b[sr.pos:], nil
 }
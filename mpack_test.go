@@ -2,10 +2,18 @@ package mpack_test
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"io"
+	"math"
 	. "mpack"
 	"reflect"
+	"runtime"
+	"strings"
+	"sync"
 	"testing"
+	"testing/iotest"
+	"time"
 )
 
 func TestPackPositiveFixnum(t *testing.T) {
@@ -126,8 +134,8 @@ func TestPackUnpackByteArray(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if n != 5 {
-		t.Fatalf("expected unpack to consume 5 bytes, not %d", n)
+	if n != 6 {
+		t.Fatalf("expected unpack to consume 6 bytes, not %d", n)
 	}
 	ba := x.([]byte)
 	if ba[0] != 0x34 || ba[1] != 0x56 || ba[2] != 0x78 || ba[3] != 0x90 {
@@ -138,7 +146,9 @@ func TestPackUnpackByteArray(t *testing.T) {
 func TestPackUnpackRaw16(t *testing.T) {
 	b := new(bytes.Buffer)
 	empty := make([]byte, 200)
-	n, err := Pack(b, empty)
+	pw := NewPackWriter(b)
+	pw.Compat = true
+	n, err := pw.Encode(empty)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -153,12 +163,57 @@ func TestPackUnpackRaw16(t *testing.T) {
 	if n != 203 {
 		t.Fatalf("expected unpack to consume 203 bytes, not %d", n)
 	}
-	ba := x.([]byte)
+	ba := x.(string)
 	if len(ba) != 200 {
 		t.Fatal("unpack didn't match")
 	}
 }
 
+func TestPackUnpackBin16(t *testing.T) {
+	b := new(bytes.Buffer)
+	n, err := Pack(b, make([]byte, 300))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 303 || b.Bytes()[0] != 0xc5 {
+		t.Fatalf("expected a 303 byte bin16, not %d bytes with prefix %x", n, b.Bytes()[0])
+	}
+	x, _, err := Unpack(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(x.([]byte)) != 300 {
+		t.Fatal("unpack didn't match")
+	}
+}
+
+func TestPackUnpackStr8(t *testing.T) {
+	s := string(bytes.Repeat([]byte("x"), 100))
+	b := new(bytes.Buffer)
+	n, err := Pack(b, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 102 || b.Bytes()[0] != 0xd9 {
+		t.Fatalf("expected a 102 byte str8, not %d bytes with prefix %x", n, b.Bytes()[0])
+	}
+	x, _, err := Unpack(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x.(string) != s {
+		t.Fatal("unpack didn't match")
+	}
+
+	c := new(bytes.Buffer)
+	pw := NewPackWriter(c)
+	pw.Compat = true
+	n, _ = pw.Encode(s)
+	if n != 103 || c.Bytes()[0] != 0xda {
+		t.Fatalf("expected compat mode to write a 103 byte raw16, not %d bytes with prefix %x", n, c.Bytes()[0])
+	}
+}
+
 func TestPackUnpackRaw32(t *testing.T) {
 	b := new(bytes.Buffer)
 	empty := make([]byte, 79000)
@@ -230,7 +285,7 @@ func TestPackUnpackArray(t *testing.T) {
 		v := reflect.ValueOf(ba[i])
 		ui[i] = uint32(v.Uint())
 	}
-	fmt.Printf("converted array: %s\n", ui)
+	fmt.Printf("converted array: %v\n", ui)
 
 	arr := NewArray(x)
 	if arr.Len() != 4 {
@@ -285,22 +340,1651 @@ func TestPackUnpackMap(t *testing.T) {
 	fmt.Printf("y[s1] = %d\n", y["s1"])
 }
 
-var a_to_pack = []interface{}{0, 1, 1314136620, 12.0, 12.0}
+type testInner struct {
+	Zip string
+}
 
-func BenchmarkPackArray(b *testing.B) {
-	fmt.Printf("!!!benchmark testing!!!\n")
-	for i := 0; i < b.N; i++ {
-		b := new(bytes.Buffer)
-		Pack(b, a_to_pack)
+type testStruct struct {
+	testInner
+	Name     string `msgpack:"name"`
+	Age      int    `msgpack:"age,omitempty"`
+	Password string `msgpack:"-"`
+	Tags     []string
+	Next     *testStruct `msgpack:",omitempty"`
+	hidden   int
+}
+
+func TestPackStruct(t *testing.T) {
+	b := new(bytes.Buffer)
+	s := testStruct{Name: "bob", Password: "secret", Tags: []string{"a"}, hidden: 4}
+	s.Zip = "60614"
+	s.Next = &testStruct{Name: "alice", Age: 30}
+	_, err := Pack(b, &s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	x, _, err := Unpack(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := NewMap(x)
+	name, present := m.StringIndex("name")
+	if !present || name != "bob" {
+		t.Errorf("expected name to be bob, not %q", name)
+	}
+	zip, present := m.StringIndex("Zip")
+	if !present || zip != "60614" {
+		t.Errorf("expected embedded Zip to be promoted, got %q", zip)
+	}
+	if _, present := m.IntIndex("age"); present {
+		t.Error("expected empty age to be omitted")
+	}
+	if _, present := m.StringIndex("Password"); present {
+		t.Error("expected Password to be skipped")
+	}
+	if _, present := m.IntIndex("hidden"); present {
+		t.Error("expected unexported field to be skipped")
+	}
+	tags, present := m.ArrayIndex("Tags")
+	if !present || tags.Len() != 1 {
+		t.Error("expected Tags to be packed as an array")
+	}
+	next, present := m.MapIndex("Next")
+	if !present || next == nil {
+		t.Fatal("expected Next to be packed as a map")
+	}
+	age, present := next.IntIndex("age")
+	if !present || age != 30 {
+		t.Errorf("expected next age to be 30, not %d", age)
+	}
+	if v, present := next.MapIndex("Next"); present {
+		t.Errorf("expected nil Next to be omitted, got %v", v)
 	}
 }
 
-var big = []int64{1285092000, 21600, 0, 1647, 1285113600, 21600, 0, 4231, 1285135200, 21600, 0, 4232, 1285156800, 21600, 0, 4230, 1285178400, 21600, 0, 1009, 1285200000, 21600, 0, 0, 1285221600, 21600, 0, 0, 1285243200, 21600, 0, 0, 1285264800, 21600, 0, 0, 1285286400, 21600, 0, 0, 1285308000, 21600, 0, 0, 1285329600, 21600, 86, 902, 1285351200, 21600, 4852, 4852, 1285372800, 21600, 8105, 8105, 1285394400, 21600, 4947, 4947, 1285416000, 21600, 4833, 4833, 1285437600, 21600, 4769, 4769, 1285459200, 21600, 4845, 4845, 1285480800, 21600, 5755, 5755, 1285502400, 21600, 4849, 4849, 1285524000, 21600, 4747, 4747, 1285545600, 21600, 4797, 4797, 1285567200, 21600, 4790, 4790, 1285588800, 21600, 3801, 3801, 1285610400, 21600, 2830, 2830, 1285632000, 21600, 4220, 4220, 1285653600, 21600, 4219, 4219, 1285675200, 21600, 4221, 4221, 1285696800, 21600, 4220, 4220, 1285718400, 21600, 4220, 4220, 1285740000, 21600, 4220, 4220, 1285761600, 21600, 4143, 4143, 1285783200, 21600, 3181, 3181, 1285804800, 21600, 4222, 4222, 1285826400, 21600, 4222, 4222, 1285848000, 21600, 2826, 2826, 1285869600, 21600, 0, 0, 1285891200, 21600, 0, 0, 1285912800, 21600, 0, 0, 1285934400, 21600, 0, 0, 1285956000, 21600, 0, 0, 1285977600, 21600, 0, 0, 1285999200, 21600, 0, 0, 1286020800, 21600, 0, 0, 1286042400, 21600, 0, 0, 1286064000, 21600, 0, 0, 1286085600, 21600, 0, 0, 1286107200, 21600, 0, 0, 1286128800, 21600, 0, 0, 1286150400, 21600, 0, 0, 1286172000, 21600, 0, 0, 1286193600, 21600, 0, 0, 1286215200, 21600, 0, 0, 1286236800, 21600, 0, 0, 1286258400, 21600, 0, 0, 1286280000, 21600, 0, 0, 1286301600, 21600, 0, 0, 1286323200, 21600, 0, 0, 1286344800, 21600, 0, 0, 1286366400, 21600, 0, 0, 1286388000, 21600, 0, 0, 1286409600, 21600, 0, 0, 1286431200, 21600, 0, 0, 1286452800, 21600, 0, 0, 1286474400, 21600, 0, 0, 1286496000, 21600, 0, 0, 1286517600, 21600, 0, 0, 1286539200, 21600, 0, 0, 1286560800, 21600, 0, 0, 1286582400, 21600, 0, 0, 1286604000, 21600, 0, 0, 1286625600, 21600, 0, 0, 1286647200, 21600, 0, 0, 1286668800, 21600, 0, 0, 1286690400, 21600, 0, 0, 1286712000, 21600, 0, 0, 1286733600, 21600, 0, 0, 1286755200, 21600, 0, 0, 1286776800, 21600, 0, 0, 1286798400, 21600, 0, 0, 1286820000, 21600, 0, 0, 1286841600, 21600, 0, 0, 1286863200, 21600, 0, 0, 1286884800, 21600, 0, 0, 1286906400, 21600, 0, 0, 1286928000, 21600, 0, 0, 1286949600, 21600, 0, 0, 1286971200, 21600, 0, 0, 1286992800, 21600, 0, 0, 1287014400, 21600, 0, 0, 1287036000, 21600, 0, 0, 1287057600, 21600, 0, 0, 1287079200, 21600, 0, 0, 1287100800, 21600, 0, 0, 1287122400, 21600, 0, 0, 1287144000, 21600, 0, 0, 1287165600, 21600, 0, 0, 1287187200, 21600, 0, 0, 1287208800, 21600, 0, 0, 1287230400, 21600, 0, 0, 1287252000, 21600, 0, 0, 1287273600, 21600, 0, 0, 1287295200, 21600, 0, 0, 1287316800, 21600, 0, 0, 1287338400, 21600, 0, 0, 1287360000, 21600, 0, 0, 1287381600, 21600, 0, 0, 1287403200, 21600, 1505, 1505, 1287424800, 21600, 4184, 4184, 1287446400, 21600, 4188, 4188, 1287468000, 21600, 4189, 4189, 1287489600, 21600, 4186, 4186, 1287511200, 21600, 4188, 4188, 1287532800, 21600, 4187, 4187, 1287554400, 21600, 4187, 4187, 1287576000, 21600, 4187, 4187, 1287597600, 21600, 4189, 4189, 1287619200, 21600, 4189, 4189, 1287640800, 21600, 4189, 4189, 1287662400, 21600, 4188, 4188, 1287684000, 21600, 4185, 4185, 1287705600, 21600, 4187, 4187, 1287727200, 21600, 4187, 4187, 1287748800, 21600, 3995, 3995, 1287770400, 21600, 777, 777, 1287792000, 21600, 0, 0, 1287813600, 21600, 0, 0, 1287835200, 21600, 0, 0, 1287856800, 21600, 0, 0, 1287878400, 21600, 0, 0, 1287900000, 21600, 0, 0, 1287921600, 21600, 0, 0, 1287943200, 21600, 0, 0, 1287964800, 21600, 0, 0, 1287986400, 21600, 0, 0, 1288008000, 21600, 0, 0, 1288029600, 21600, 0, 0, 1288051200, 21600, 0, 0, 1288072800, 21600, 0, 0, 1288094400, 21600, 0, 0, 1288116000, 21600, 0, 0, 1288137600, 21600, 0, 0, 1288159200, 21600, 0, 0, 1288180800, 21600, 0, 0, 1288202400, 21600, 0, 0, 1288224000, 21600, 0, 0, 1288245600, 21600, 0, 0, 1288267200, 21600, 0, 0, 1288288800, 21600, 0, 0, 1288310400, 21600, 0, 0, 1288332000, 21600, 0, 0, 1288353600, 21600, 1052, 1052, 1288375200, 21600, 4656, 4656, 1288396800, 21600, 4676, 4676, 1288418400, 21600, 4709, 4709, 1288440000, 21600, 4729, 4729, 1288461600, 21600, 4674, 4674, 1288483200, 21600, 4704, 4704, 1288504800, 21600, 4689, 4689, 1288526400, 21600, 4720, 4720, 1288548000, 21600, 4713, 4713, 1288569600, 21600, 4737, 4737, 1288591200, 21600, 4714, 4714, 1288612800, 21600, 4736, 4736, 1288634400, 21600, 4709, 4709, 1288656000, 21600, 4716, 4716, 1288677600, 21600, 4724, 4724, 1288699200, 21600, 4735, 4735, 1288720800, 21600, 4714, 4714, 1288742400, 21600, 4697, 4697, 1288764000, 21600, 4679, 4679, 1288785600, 21600, 4703, 4703, 1288807200, 21600, 4696, 4696, 1288828800, 21600, 4683, 4683, 1288850400, 21600, 4690, 4690, 1288872000, 21600, 4805, 4805, 1288893600, 21600, 4695, 4695, 1288915200, 21600, 4697, 4697, 1288936800, 21600, 4704, 4704, 1288958400, 21600, 4677, 4677, 1288980000, 21600, 1964, 1964, 1289001600, 21600, 0, 0, 1289023200, 21600, 0, 0, 1289044800, 21600, 0, 0, 1289066400, 21600, 0, 0, 1289088000, 21600, 0, 0, 1289109600, 21600, 0, 0, 1289131200, 21600, 0, 0, 1289152800, 21600, 0, 0, 1289174400, 21600, 0, 0, 1289196000, 21600, 0, 0, 1289217600, 21600, 0, 0, 1289239200, 21600, 0, 0, 1289260800, 21600, 0, 0, 1289282400, 21600, 0, 0, 1289304000, 21600, 0, 0, 1289325600, 21600, 0, 0, 1289347200, 21600, 0, 0, 1289368800, 21600, 0, 0, 1289390400, 21600, 0, 0, 1289412000, 21600, 0, 0, 1289433600, 21600, 0, 0, 1289455200, 21600, 0, 0, 1289476800, 21600, 0, 0, 1289498400, 21600, 0, 0, 1289520000, 21600, 0, 0, 1289541600, 21600, 0, 0, 1289563200, 21600, 0, 0, 1289584800, 21600, 0, 0, 1289606400, 21600, 0, 0, 1289628000, 21600, 0, 0, 1289649600, 21600, 0, 0, 1289671200, 21600, 0, 0, 1289692800, 21600, 0, 0, 1289714400, 21600, 0, 0, 1289736000, 21600, 0, 0, 1289757600, 21600, 0, 0, 1289779200, 21600, 0, 0, 1289800800, 21600, 0, 0, 1289822400, 21600, 0, 0, 1289844000, 21600, 0, 0, 1289865600, 21600, 0, 0, 1289887200, 21600, 0, 0, 1289908800, 21600, 0, 0, 1289930400, 21600, 0, 0, 1289952000, 21600, 0, 0, 1289973600, 21600, 0, 0, 1289995200, 21600, 0, 0, 1290016800, 21600, 0, 0, 1290038400, 21600, 0, 0, 1290060000, 21600, 0, 0, 1290081600, 21600, 0, 0, 1290103200, 21600, 0, 0, 1290124800, 21600, 0, 0, 1290146400, 21600, 0, 0, 1290168000, 21600, 995, 995, 1290189600, 21600, 4152, 4152, 1290211200, 21600, 4180, 4180, 1290232800, 21600, 4184, 4184, 1290254400, 21600, 4183, 4183, 1290276000, 21600, 4183, 4183, 1290297600, 21600, 4182, 4182, 1290319200, 21600, 4182, 4182, 1290340800, 21600, 4181, 4181, 1290362400, 21600, 4185, 4185, 1290384000, 21600, 4185, 4185, 1290405600, 21600, 4184, 4184, 1290427200, 21600, 4182, 4182, 1290448800, 21600, 2455, 2455, 1293559200, 21600, 3416, 3416, 1293580800, 21600, 4196, 4196, 1293602400, 21600, 4190, 4190, 1293624000, 21600, 3559, 3559, 1293645600, 21600, 0, 0, 1293667200, 21600, 0, 0, 1293688800, 21600, 0, 0, 1293710400, 21600, 0, 0, 1293732000, 21600, 1259, 1259, 1293753600, 21600, 4182, 4182, 1293775200, 21600, 4177, 4177, 1293796800, 21600, 4187, 4187, 1293818400, 21600, 4176, 4176, 1293840000, 21600, 4187, 4187, 1293861600, 21600, 4177, 4177, 1293883200, 21600, 4182, 4182, 1293904800, 21600, 4172, 4172, 1293926400, 21600, 4188, 4188, 1293948000, 21600, 4179, 4179, 1293969600, 21600, 4185, 4185, 1293991200, 21600, 4177, 4177, 1294012800, 21600, 4184, 4184, 1294034400, 21600, 4176, 4176, 1294056000, 21600, 4176, 4176, 1294077600, 21600, 4163, 4163, 1294099200, 21600, 4165, 4165, 1294120800, 21600, 4184, 4184, 1294142400, 21600, 4184, 4184, 1294164000, 21600, 2096, 2096, 1294185600, 21600, 0, 0, 1294207200, 21600, 0, 0, 1294228800, 21600, 0, 0, 1294250400, 21600, 0, 0, 1294272000, 21600, 0, 0, 1294293600, 21600, 0, 0, 1294315200, 21600, 0, 0, 1294336800, 21600, 0, 0, 1294358400, 21600, 0, 0, 1294380000, 21600, 0, 0, 1294401600, 21600, 1195, 1195, 1294423200, 21600, 4186, 4186, 1294444800, 21600, 4192, 4192, 1294466400, 21600, 4191, 4191, 1294488000, 21600, 4191, 4191, 1294509600, 21600, 4191, 4191, 1294531200, 21600, 4189, 4189, 1294552800, 21600, 4190, 4190, 1294574400, 21600, 4188, 4188, 1294596000, 21600, 4190, 4190, 1294617600, 21600, 4189, 4189, 1294639200, 21600, 4187, 4187, 1294660800, 21600, 4191, 4191, 1294682400, 21600, 4172, 4172, 1294704000, 21600, 4189, 4189, 1294725600, 21600, 4191, 4191, 1294747200, 21600, 4190, 4190, 1294768800, 21600, 3094, 3094, 1297166400, 21600, 32, 32, 1297188000, 21600, 0, 0, 1297209600, 21600, 0, 0, 1297231200, 21600, 0, 0, 1297252800, 21600, 0, 0, 1297274400, 21600, 0, 0, 1297296000, 21600, 0, 0, 1297317600, 21600, 0, 0, 1297339200, 21600, 0, 0, 1297360800, 21600, 1492, 1492, 1297382400, 21600, 3304, 3304, 1297404000, 21600, 0, 0, 1299499200, 21600, 0, 0, 1299520800, 21600, 411, 411, 1299542400, 21600, 0, 0, 1299564000, 21600, 0, 0, 1299585600, 21600, 0, 0, 1299607200, 21600, 0, 0, 1299628800, 21600, 0, 0, 1299650400, 21600, 0, 0, 1299672000, 21600, 0, 0, 1299693600, 21600, 0, 0, 1299715200, 21600, 0, 0, 1299736800, 21600, 0, 0, 1299758400, 21600, 0, 0, 1299780000, 21600, 0, 0, 1299801600, 21600, 0, 0, 1299823200, 21600, 0, 0, 1299844800, 21600, 0, 0, 1299866400, 21600, 0, 0, 1299888000, 21600, 0, 0, 1299909600, 21600, 0, 0, 1299931200, 21600, 0, 0, 1299952800, 21600, 0, 0, 1299974400, 21600, 0, 0, 1299996000, 21600, 0, 0, 1300017600, 21600, 0, 0, 1300039200, 21600, 0, 0, 1300060800, 21600, 0, 0, 1300082400, 21600, 0, 0, 1300104000, 21600, 0, 0, 1300125600, 21600, 0, 0, 1300147200, 21600, 0, 0, 1300168800, 21600, 0, 0, 1300190400, 21600, 5, 5, 1300212000, 21600, 0, 0, 1300233600, 21600, 0, 0, 1300255200, 21600, 0, 0, 1300276800, 21600, 0, 0, 1300298400, 21600, 0, 0, 1300320000, 21600, 0, 0, 1300341600, 21600, 0, 0, 1300363200, 21600, 1, 1, 1300384800, 21600, 0, 0, 1300406400, 21600, 0, 0, 1300428000, 21600, 0, 0, 1300449600, 21600, 0, 0, 1300471200, 21600, 0, 0, 1300492800, 21600, 0, 0, 1300514400, 21600, 0, 0, 1300536000, 21600, 0, 0, 1300557600, 21600, 0, 0, 1300579200, 21600, 0, 0, 1300600800, 21600, 0, 0, 1300622400, 21600, 0, 0, 1300644000, 21600, 0, 0, 1300665600, 21600, 0, 0, 1300687200, 21600, 0, 0, 1300708800, 21600, 0, 0, 1300730400, 21600, 0, 0, 1300752000, 21600, 0, 0, 1300773600, 21600, 0, 0, 1300795200, 21600, 0, 0, 1300816800, 21600, 0, 0, 1300838400, 21600, 0, 0, 1300860000, 21600, 0, 0, 1300881600, 21600, 0, 0, 1300903200, 21600, 0, 0, 1300924800, 21600, 0, 0, 1300946400, 21600, 0, 0, 1300968000, 21600, 0, 0, 1300989600, 21600, 0, 0, 1301011200, 21600, 0, 0, 1301032800, 21600, 0, 0, 1301054400, 21600, 0, 0, 1301076000, 21600, 0, 0, 1301097600, 21600, 0, 0, 1301119200, 21600, 0, 0, 1301140800, 21600, 0, 0, 1301162400, 21600, 0, 0, 1301184000, 21600, 0, 0, 1301205600, 21600, 0, 0, 1301227200, 21600, 0, 0, 1301248800, 21600, 0, 0, 1301270400, 21600, 0, 0, 1301292000, 21600, 0, 0, 1301313600, 21600, 0, 0, 1301335200, 21600, 3578, 3578, 1301356800, 21600, 3740, 3740, 1301378400, 21600, 2366, 2366, 1301400000, 21600, 0, 0, 1301421600, 21600, 0, 0, 1301443200, 21600, 0, 0, 1301464800, 21600, 0, 0, 1301486400, 21600, 0, 0, 1301508000, 21600, 0, 0, 1301529600, 21600, 0, 0, 1301551200, 21600, 0, 0, 1301572800, 21600, 0, 0, 1301594400, 21600, 0, 0, 1301616000, 21600, 0, 0, 1301637600, 21600, 0, 0, 1301659200, 21600, 5, 5, 1301680800, 21600, 0, 0, 1301702400, 21600, 0, 0, 1301724000, 21600, 0, 0, 1301745600, 21600, 0, 0, 1301767200, 21600, 0, 0, 1301788800, 21600, 0, 0, 1301810400, 21600, 0, 0, 1301832000, 21600, 0, 0, 1301853600, 21600, 0, 0, 1301875200, 21600, 0, 0, 1301896800, 21600, 0, 0, 1301918400, 21600, 0, 0, 1301940000, 21600, 0, 0, 1301961600, 21600, 0, 0, 1301983200, 21600, 0, 0, 1302004800, 21600, 0, 0, 1302026400, 21600, 0, 0, 1302048000, 21600, 0, 0, 1302069600, 21600, 0, 0, 1302091200, 21600, 0, 0, 1302112800, 21600, 0, 0, 1302134400, 21600, 0, 0, 1302156000, 21600, 0, 0, 1302177600, 21600, 0, 0, 1302199200, 21600, 0, 0, 1302220800, 21600, 0, 0, 1302242400, 21600, 0, 0, 1302264000, 21600, 0, 0, 1302285600, 21600, 0, 0, 1302307200, 21600, 0, 0, 1302328800, 21600, 0, 0, 1302350400, 21600, 0, 0, 1302372000, 21600, 0, 0, 1302393600, 21600, 0, 0, 1302415200, 21600, 0, 0, 1302436800, 21600, 0, 0, 1302458400, 21600, 0, 0, 1302480000, 21600, 0, 0, 1302501600, 21600, 0, 0, 1302523200, 21600, 0, 0, 1302544800, 21600, 0, 0, 1302566400, 21600, 0, 0, 1302588000, 21600, 0, 0, 1302609600, 21600, 0, 0, 1302631200, 21600, 0, 0, 1302652800, 21600, 0, 0, 1302674400, 21600, 0, 0, 1302696000, 21600, 0, 0, 1302717600, 21600, 0, 0, 1302739200, 21600, 0, 0, 1302760800, 21600, 0, 0, 1302782400, 21600, 0, 0, 1302804000, 21600, 0, 0, 1302825600, 21600, 0, 0, 1302847200, 21600, 0, 0, 1302868800, 21600, 0, 0, 1302890400, 21600, 0, 0, 1302912000, 21600, 0, 0, 1302933600, 21600, 0, 0, 1302955200, 21600, 0, 0, 1302976800, 21600, 0, 0, 1302998400, 21600, 0, 0, 1303020000, 21600, 0, 0, 1303041600, 21600, 0, 0, 1303063200, 21600, 0, 0, 1303084800, 21600, 0, 0, 1303106400, 21600, 0, 0, 1303128000, 21600, 0, 0, 1303149600, 21600, 0, 0, 1303171200, 21600, 0, 0, 1303192800, 21600, 0, 0, 1303214400, 21600, 0, 0, 1303236000, 21600, 0, 0, 1303257600, 21600, 0, 0, 1303279200, 21600, 0, 0, 1303300800, 21600, 0, 0, 1303322400, 21600, 0, 0, 1303344000, 21600, 0, 0, 1303365600, 21600, 0, 0, 1303387200, 21600, 0, 0, 1303408800, 21600, 0, 0, 1303430400, 21600, 0, 0, 1303452000, 21600, 0, 0, 1303473600, 21600, 0, 0, 1303495200, 21600, 0, 0, 1303516800, 21600, 0, 0, 1303538400, 21600, 0, 0, 1303560000, 21600, 0, 0, 1303581600, 21600, 0, 0, 1303603200, 21600, 0, 0, 1303624800, 21600, 0, 0, 1303646400, 21600, 0, 0, 1303668000, 21600, 0, 0, 1303689600, 21600, 0, 0, 1303711200, 21600, 0, 0, 1303732800, 21600, 0, 0, 1303754400, 21600, 0, 0, 1303776000, 21600, 0, 0, 1303797600, 21600, 0, 0, 1303819200, 21600, 0, 0, 1303840800, 21600, 0, 0, 1303862400, 21600, 0, 0, 1303884000, 21600, 0, 0, 1303905600, 21600, 0, 0, 1303927200, 21600, 0, 0, 1303948800, 21600, 0, 0, 1303970400, 21600, 0, 0, 1303992000, 21600, 0, 0, 1304013600, 21600, 0, 0, 1304035200, 21600, 0, 0, 1304056800, 21600, 0, 0, 1304078400, 21600, 0, 0, 1304100000, 21600, 0, 0, 1304121600, 21600, 0, 0, 1304143200, 21600, 0, 0, 1304164800, 21600, 0, 0, 1304186400, 21600, 0, 0, 1304208000, 21600, 0, 0, 1304229600, 21600, 0, 0, 1304251200, 21600, 0, 0, 1304272800, 21600, 0, 0, 1304294400, 21600, 0, 0, 1304316000, 21600, 0, 0, 1304337600, 21600, 0, 0, 1304359200, 21600, 0, 0, 1304380800, 21600, 0, 0, 1304402400, 21600, 0, 0, 1304424000, 21600, 0, 0, 1304445600, 21600, 0, 0, 1304467200, 21600, 0, 0, 1304488800, 21600, 0, 0, 1304510400, 21600, 0, 0, 1304532000, 21600, 0, 0, 1304553600, 21600, 0, 0, 1304575200, 21600, 0, 0, 1304596800, 21600, 0, 0, 1304618400, 21600, 0, 0, 1304640000, 21600, 0, 0, 1304661600, 21600, 0, 0, 1304683200, 21600, 0, 0, 1304704800, 21600, 0, 0, 1304726400, 21600, 0, 0, 1304748000, 21600, 0, 0, 1304769600, 21600, 0, 0, 1304791200, 21600, 0, 0, 1304812800, 21600, 0, 0, 1304834400, 21600, 0, 0, 1304856000, 21600, 0, 0, 1304877600, 21600, 0, 0, 1304899200, 21600, 0, 0, 1304920800, 21600, 0, 0, 1304942400, 21600, 0, 0, 1304964000, 21600, 0, 0, 1304985600, 21600, 0, 0, 1305007200, 21600, 0, 0, 1305028800, 21600, 0, 0, 1305050400, 21600, 0, 0, 1305072000, 21600, 0, 0, 1305093600, 21600, 0, 0, 1305115200, 21600, 0, 0, 1305136800, 21600, 0, 0, 1305158400, 21600, 0, 0, 1305180000, 21600, 0, 0, 1305201600, 21600, 0, 0, 1305223200, 21600, 0, 0, 1305244800, 21600, 0, 0, 1305266400, 21600, 0, 0, 1305288000, 21600, 0, 0, 1305309600, 21600, 0, 0, 1305331200, 21600, 0, 0, 1305352800, 21600, 0, 0, 1305374400, 21600, 0, 0, 1305396000, 21600, 0, 0, 1305417600, 21600, 0, 0, 1305439200, 21600, 0, 0, 1305460800, 21600, 0, 0, 1305482400, 21600, 0, 0, 1305504000, 21600, 0, 0, 1305525600, 21600, 0, 0, 1305547200, 21600, 0, 0, 1305568800, 21600, 0, 0, 1305590400, 21600, 0, 0, 1305612000, 21600, 0, 0, 1305633600, 21600, 0, 0, 1305655200, 21600, 0, 0, 1305676800, 21600, 0, 0, 1305698400, 21600, 0, 0, 1305720000, 21600, 0, 0, 1305741600, 21600, 0, 0, 1305763200, 21600, 0, 0, 1305784800, 21600, 0, 0, 1305806400, 21600, 0, 0, 1305828000, 21600, 0, 0, 1305849600, 21600, 0, 0, 1305871200, 21600, 0, 0, 1305892800, 21600, 0, 0, 1305914400, 21600, 0, 0, 1305936000, 21600, 0, 0, 1305957600, 21600, 0, 0, 1305979200, 21600, 0, 0, 1306000800, 21600, 0, 0, 1306022400, 21600, 0, 0, 1306044000, 21600, 0, 0, 1306065600, 21600, 0, 0, 1306087200, 21600, 0, 0, 1306108800, 21600, 0, 0, 1306130400, 21600, 0, 0, 1306152000, 21600, 0, 0, 1306173600, 21600, 0, 0, 1306195200, 21600, 0, 0, 1306216800, 21600, 0, 0, 1306238400, 21600, 0, 0, 1306260000, 21600, 0, 0, 1306281600, 21600, 0, 0, 1306303200, 21600, 0, 0, 1306324800, 21600, 0, 0, 1306346400, 21600, 0, 0, 1306368000, 21600, 0, 0, 1306389600, 21600, 0, 0, 1306411200, 21600, 0, 0, 1306432800, 21600, 0, 0, 1306454400, 21600, 0, 0, 1306476000, 21600, 0, 0, 1306497600, 21600, 0, 0, 1306519200, 21600, 0, 0, 1306540800, 21600, 0, 0, 1306562400, 21600, 0, 0, 1306584000, 21600, 0, 0, 1306605600, 21600, 0, 0, 1306627200, 21600, 0, 0, 1306648800, 21600, 0, 0, 1306670400, 21600, 0, 0, 1306692000, 21600, 0, 0, 1306713600, 21600, 0, 0, 1306735200, 21600, 0, 0, 1306756800, 21600, 0, 0, 1306778400, 21600, 0, 0, 1306800000, 21600, 0, 0, 1306821600, 21600, 0, 0, 1306843200, 21600, 0, 0, 1306864800, 21600, 0, 0, 1306886400, 21600, 0, 0, 1306908000, 21600, 0, 0, 1306929600, 21600, 0, 0, 1306951200, 21600, 1675, 1675, 1306972800, 21600, 4317, 4317, 1306994400, 21600, 4317, 4317, 1307016000, 21600, 4317, 4317, 1307037600, 21600, 4317, 4317, 1307059200, 21600, 4317, 4317, 1307080800, 21600, 4317, 4317, 1307102400, 21600, 4317, 4317, 1307124000, 21600, 4317, 4317, 1307145600, 21600, 4317, 4317, 1307167200, 21600, 4317, 4317, 1307188800, 21600, 4317, 4317, 1307210400, 21600, 4317, 4317, 1307232000, 21600, 4317, 4317, 1307253600, 21600, 4317, 4317, 1307275200, 21600, 4317, 4317, 1307296800, 21600, 4317, 4317, 1307318400, 21600, 4317, 4317, 1307340000, 21600, 4317, 4317, 1307361600, 21600, 4317, 4317, 1307383200, 21600, 3898, 3898, 1307404800, 21600, 0, 0, 1307426400, 21600, 0, 0, 1307448000, 21600, 2436, 2436, 1307469600, 21600, 4317, 4317, 1307491200, 21600, 4317, 4317, 1307512800, 21600, 0, 0, 1307534400, 21600, 4317, 4317, 1307556000, 21600, 4317, 4317, 1307577600, 21600, 4317, 4317, 1307599200, 21600, 4317, 4317, 1307620800, 21600, 4317, 4317, 1307642400, 21600, 4317, 4317, 1307664000, 21600, 4317, 4317, 1307685600, 21600, 4317, 4317, 1307707200, 21600, 4317, 4317, 1307728800, 21600, 4317, 4317, 1307750400, 21600, 4317, 4317, 1307772000, 21600, 4317, 4317, 1307793600, 21600, 4317, 4317, 1307815200, 21600, 4317, 4317, 1307836800, 21600, 4317, 4317, 1307858400, 21600, 4317, 4317, 1307880000, 21600, 4317, 4317, 1307901600, 21600, 4317, 4317, 1307923200, 21600, 4317, 4317, 1307944800, 21600, 4317, 4317, 1307966400, 21600, 4317, 4317, 1307988000, 21600, 4317, 4317, 1308009600, 21600, 4317, 4317, 1308031200, 21600, 4316, 4316, 1308052800, 21600, 4317, 4317, 1308074400, 21600, 4317, 4317, 1308096000, 21600, 4317, 4317, 1308117600, 21600, 4317, 4317, 1308139200, 21600, 4317, 4317, 1308160800, 21600, 4317, 4317, 1308182400, 21600, 4317, 4317, 1308204000, 21600, 4317, 4317, 1308225600, 21600, 4317, 4317, 1308247200, 21600, 4316, 4316, 1308268800, 21600, 4317, 4317, 1308290400, 21600, 4317, 4317, 1308312000, 21600, 4317, 4317, 1308333600, 21600, 4317, 4317, 1308355200, 21600, 4317, 4317, 1308376800, 21600, 4317, 4317, 1308398400, 21600, 4317, 4317, 1308420000, 21600, 4317, 4317, 1308441600, 21600, 4317, 4317, 1308463200, 21600, 4316, 4316, 1308484800, 21600, 4317, 4317, 1308506400, 21600, 4317, 4317, 1308528000, 21600, 4317, 4317, 1308549600, 21600, 4317, 4317, 1308571200, 21600, 4317, 4317, 1308592800, 21600, 4317, 4317, 1308614400, 21600, 4317, 4317, 1308636000, 21600, 0, 0, 1308657600, 21600, 2338, 2338, 1313096400, 900, 0, 0, 1313097300, 900, 11, 11, 1313098200, 900, 192, 192, 1313099100, 900, 179, 179, 1313100000, 900, 180, 180, 1313100900, 900, 180, 180, 1313101800, 900, 180, 180, 1313102700, 900, 180, 180, 1313103600, 900, 180, 180, 1313104500, 900, 179, 179, 1313105400, 900, 180, 180, 1313106300, 900, 180, 180, 1313107200, 900, 180, 180, 1313108100, 900, 180, 180, 1313109000, 900, 179, 179, 1313109900, 900, 180, 180, 1313110800, 900, 180, 180, 1313111700, 900, 180, 180, 1313112600, 900, 180, 180, 1313113500, 900, 180, 180, 1313114400, 900, 179, 179, 1313115300, 900, 180, 180, 1313116200, 900, 180, 180, 1313117100, 900, 180, 180, 1313118000, 900, 180, 180, 1313118900, 900, 180, 180, 1313119800, 900, 179, 179, 1313120700, 900, 180, 180, 1313121600, 900, 180, 180, 1313122500, 900, 180, 180, 1313123400, 900, 180, 180, 1313124300, 900, 179, 179, 1313125200, 900, 180, 180, 1313126100, 900, 180, 180, 1313127000, 900, 180, 180, 1313127900, 900, 180, 180, 1313128800, 900, 180, 180, 1313129700, 900, 179, 179, 1313130600, 900, 180, 180, 1313131500, 900, 180, 180, 1313132400, 900, 180, 180, 1313133300, 900, 180, 180, 1313134200, 900, 179, 179, 1313135100, 900, 180, 180, 1313136000, 900, 180, 180, 1313136900, 900, 180, 180, 1313137800, 900, 180, 180, 1313138700, 900, 180, 180, 1313139600, 900, 179, 179, 1313140500, 900, 180, 180, 1313141400, 900, 180, 180, 1313142300, 900, 180, 180, 1313143200, 900, 180, 180, 1313144100, 900, 180, 180, 1313145000, 900, 179, 179, 1313145900, 900, 180, 180, 1313146800, 900, 180, 180, 1313147700, 900, 180, 180, 1313148600, 900, 180, 180, 1313149500, 900, 180, 180, 1313150400, 900, 179, 179, 1313151300, 900, 180, 180, 1313152200, 900, 180, 180, 1313153100, 900, 180, 180, 1313154000, 900, 180, 180, 1313154900, 900, 179, 179, 1313155800, 900, 180, 180, 1313156700, 900, 180, 180, 1313157600, 900, 180, 180, 1313158500, 900, 180, 180, 1313159400, 900, 180, 180, 1313160300, 900, 179, 179, 1313161200, 900, 180, 180, 1313162100, 900, 180, 180, 1313163000, 900, 180, 180, 1313163900, 900, 180, 180, 1313164800, 900, 180, 180, 1313165700, 900, 179, 179, 1313166600, 900, 180, 180, 1313167500, 900, 180, 180, 1313168400, 900, 180, 180, 1313169300, 900, 180, 180, 1313170200, 900, 179, 179, 1313171100, 900, 180, 180, 1313172000, 900, 180, 180, 1313172900, 900, 180, 180, 1313173800, 900, 180, 180, 1313174700, 900, 179, 179, 1313175600, 900, 180, 180, 1313176500, 900, 180, 180, 1313177400, 900, 180, 180, 1313178300, 900, 180, 180, 1313179200, 900, 180, 180, 1313180100, 900, 179, 179, 1313181000, 900, 180, 180, 1313181900, 900, 180, 180, 1313182800, 900, 180, 180, 1313183700, 900, 180, 180, 1313184600, 900, 180, 180, 1313185500, 900, 179, 179, 1313186400, 900, 180, 180, 1313187300, 900, 180, 180, 1313188200, 900, 180, 180, 1313189100, 900, 180, 180, 1313190000, 900, 179, 179, 1313190900, 900, 180, 180, 1313191800, 900, 180, 180, 1313192700, 900, 180, 180, 1313193600, 900, 180, 180, 1313194500, 900, 180, 180, 1313195400, 900, 179, 179, 1313196300, 900, 180, 180, 1313197200, 900, 180, 180, 1313198100, 900, 180, 180, 1313199000, 900, 180, 180, 1313199900, 900, 180, 180, 1313200800, 900, 179, 179, 1313201700, 900, 180, 180, 1313202600, 900, 180, 180, 1313203500, 900, 180, 180, 1313204400, 900, 180, 180, 1313205300, 900, 179, 179, 1313206200, 900, 180, 180, 1313207100, 900, 180, 180, 1313208000, 900, 180, 180, 1313208900, 900, 180, 180, 1313209800, 900, 180, 180, 1313210700, 900, 179, 179, 1313211600, 900, 180, 180, 1313212500, 900, 180, 180, 1313213400, 900, 180, 180, 1313214300, 900, 180, 180, 1313215200, 900, 180, 180, 1313216100, 900, 179, 179, 1313217000, 900, 180, 180, 1313217900, 900, 180, 180, 1313218800, 900, 180, 180, 1313219700, 900, 180, 180, 1313220600, 900, 180, 180, 1313221500, 900, 179, 179, 1313222400, 900, 180, 180, 1313223300, 900, 180, 180, 1313224200, 900, 180, 180, 1313225100, 900, 180, 180, 1313226000, 900, 179, 179, 1313226900, 900, 180, 180, 1313227800, 900, 180, 180, 1313228700, 900, 180, 180, 1313229600, 900, 180, 180, 1313230500, 900, 180, 180, 1313231400, 900, 179, 179, 1313232300, 900, 180, 180, 1313233200, 900, 180, 180, 1313234100, 900, 180, 180, 1313235000, 900, 180, 180, 1313235900, 900, 180, 180, 1313236800, 900, 179, 179, 1313237700, 900, 180, 180, 1313238600, 900, 180, 180, 1313239500, 900, 180, 180, 1313240400, 900, 180, 180, 1313241300, 900, 180, 180, 1313242200, 900, 179, 179, 1313243100, 900, 180, 180, 1313244000, 900, 180, 180, 1313244900, 900, 180, 180, 1313245800, 900, 180, 180, 1313246700, 900, 179, 179, 1313247600, 900, 180, 180, 1313248500, 900, 180, 180, 1313249400, 900, 180, 180, 1313250300, 900, 180, 180, 1313251200, 900, 180, 180, 1313252100, 900, 179, 179, 1313253000, 900, 180, 180, 1313253900, 900, 180, 180, 1313254800, 900, 180, 180, 1313255700, 900, 180, 180, 1313256600, 900, 180, 180, 1313257500, 900, 179, 179, 1313258400, 900, 180, 180, 1313259300, 900, 180, 180, 1313260200, 900, 180, 180, 1313261100, 900, 180, 180, 1313262000, 900, 179, 179, 1313262900, 900, 180, 180, 1313263800, 900, 180, 180, 1313264700, 900, 180, 180, 1313265600, 900, 180, 180, 1313266500, 900, 180, 180, 1313267400, 900, 179, 179, 1313268300, 900, 180, 180, 1313269200, 900, 180, 180, 1313270100, 900, 180, 180, 1313271000, 900, 180, 180, 1313271900, 900, 180, 180, 1313272800, 900, 179, 179, 1313273700, 900, 180, 180, 1313274600, 900, 180, 180, 1313275500, 900, 180, 180, 1313276400, 900, 180, 180, 1313277300, 900, 180, 180, 1313278200, 900, 179, 179, 1313279100, 900, 180, 180, 1313280000, 900, 180, 180, 1313280900, 900, 180, 180, 1313281800, 900, 180, 180, 1313282700, 900, 179, 179, 1313283600, 900, 180, 180, 1313284500, 900, 180, 180, 1313285400, 900, 180, 180, 1313286300, 900, 180, 180, 1313287200, 900, 180, 180, 1313288100, 900, 179, 179, 1313289000, 900, 180, 180, 1313289900, 900, 180, 180, 1313290800, 900, 180, 180, 1313291700, 900, 180, 180, 1313292600, 900, 179, 179, 1313293500, 900, 180, 180, 1313294400, 900, 180, 180, 1313295300, 900, 180, 180, 1313296200, 900, 180, 180, 1313297100, 900, 180, 180, 1313298000, 900, 179, 179, 1313298900, 900, 180, 180, 1313299800, 900, 180, 180, 1313300700, 900, 180, 180, 1313301600, 900, 180, 180, 1313302500, 900, 180, 180, 1313303400, 900, 179, 179, 1313304300, 900, 180, 180, 1313305200, 900, 180, 180, 1313306100, 900, 180, 180, 1313307000, 900, 180, 180, 1313307900, 900, 179, 179, 1313308800, 900, 180, 180, 1313309700, 900, 180, 180, 1313310600, 900, 180, 180, 1313311500, 900, 180, 180, 1313312400, 900, 180, 180, 1313313300, 900, 179, 179, 1313314200, 900, 180, 180, 1313315100, 900, 180, 180, 1313316000, 900, 180, 180, 1313316900, 900, 180, 180, 1313317800, 900, 179, 179, 1313318700, 900, 180, 180, 1313319600, 900, 180, 180, 1313320500, 900, 180, 180, 1313321400, 900, 180, 180, 1313322300, 900, 180, 180, 1313323200, 900, 179, 179, 1313324100, 900, 180, 180, 1313325000, 900, 180, 180, 1313325900, 900, 180, 180, 1313326800, 900, 180, 180, 1313327700, 900, 180, 180, 1313328600, 900, 179, 179, 1313329500, 900, 180, 180, 1313330400, 900, 180, 180, 1313331300, 900, 180, 180, 1313332200, 900, 180, 180, 1313333100, 900, 180, 180, 1313334000, 900, 179, 179, 1313334900, 900, 180, 180, 1313335800, 900, 0, 0, 1313336700, 900, 180, 180, 1313337600, 900, 180, 180, 1313338500, 900, 179, 179, 1313339400, 900, 0, 0, 1313340300, 900, 180, 180, 1313341200, 900, 180, 180, 1313342100, 900, 180, 180, 1313343000, 900, 0, 0, 1313343900, 900, 180, 180, 1313344800, 900, 180, 180, 1313345700, 900, 180, 180, 1313346600, 900, 180, 180, 1313347500, 900, 179, 179, 1313348400, 900, 180, 180, 1313349300, 900, 180, 180, 1313350200, 900, 180, 180, 1313351100, 900, 180, 180, 1313352000, 900, 180, 180, 1313352900, 900, 179, 179, 1313353800, 900, 180, 180, 1313354700, 900, 180, 180, 1313355600, 900, 180, 180, 1313356500, 900, 180, 180, 1313357400, 900, 179, 179, 1313358300, 900, 180, 180, 1313359200, 900, 180, 180, 1313360100, 900, 180, 180, 1313361000, 900, 180, 180, 1313361900, 900, 180, 180, 1313362800, 900, 179, 179, 1313363700, 900, 180, 180, 1313364600, 900, 180, 180, 1313365500, 900, 180, 180, 1313366400, 900, 180, 180, 1313367300, 900, 180, 180, 1313368200, 900, 179, 179, 1313369100, 900, 180, 180, 1313370000, 900, 180, 180, 1313370900, 900, 180, 180, 1313371800, 900, 180, 180, 1313372700, 900, 180, 180, 1313373600, 900, 179, 179, 1313374500, 900, 180, 180, 1313375400, 900, 180, 180, 1313376300, 900, 180, 180, 1313377200, 900, 180, 180, 1313378100, 900, 179, 179, 1313379000, 900, 180, 180, 1313379900, 900, 180, 180, 1313380800, 900, 180, 180, 1313381700, 900, 180, 180, 1313382600, 900, 180, 180, 1313383500, 900, 179, 179, 1313384400, 900, 180, 180, 1313385300, 900, 180, 180, 1313386200, 900, 180, 180, 1313387100, 900, 180, 180, 1313388000, 900, 180, 180, 1313388900, 900, 179, 179, 1313389800, 900, 180, 180, 1313390700, 900, 180, 180, 1313391600, 900, 180, 180, 1313392500, 900, 180, 180, 1313393400, 900, 180, 180, 1313394300, 900, 179, 179, 1313395200, 900, 180, 180, 1313396100, 900, 180, 180, 1313397000, 900, 180, 180, 1313397900, 900, 180, 180, 1313398800, 900, 179, 179, 1313399700, 900, 180, 180, 1313400600, 900, 180, 180, 1313401500, 900, 180, 180, 1313402400, 900, 180, 180, 1313403300, 900, 180, 180, 1313404200, 900, 179, 179, 1313405100, 900, 180, 180, 1313406000, 900, 180, 180, 1313406900, 900, 180, 180, 1313407800, 900, 180, 180, 1313408700, 900, 180, 180, 1313409600, 900, 179, 179, 1313410500, 900, 180, 180, 1313411400, 900, 180, 180, 1313412300, 900, 180, 180, 1313413200, 900, 180, 180, 1313414100, 900, 180, 180, 1313415000, 900, 179, 179, 1313415900, 900, 180, 180, 1313416800, 900, 180, 180, 1313417700, 900, 180, 180, 1313418600, 900, 179, 179, 1313419500, 900, 180, 180, 1313420400, 900, 180, 180, 1313421300, 900, 180, 180, 1313422200, 900, 180, 180, 1313423100, 900, 179, 179, 1313424000, 900, 180, 180, 1313424900, 900, 180, 180, 1313425800, 900, 180, 180, 1313426700, 900, 180, 180, 1313427600, 900, 180, 180, 1313428500, 900, 179, 179, 1313429400, 900, 180, 180, 1313430300, 900, 180, 180, 1313431200, 900, 180, 180, 1313432100, 900, 180, 180, 1313433000, 900, 180, 180, 1313433900, 900, 179, 179, 1313434800, 900, 180, 180, 1313435700, 900, 180, 180, 1313436600, 900, 180, 180, 1313437500, 900, 180, 180, 1313438400, 900, 179, 179, 1313439300, 900, 180, 180, 1313440200, 900, 180, 180, 1313441100, 900, 180, 180, 1313442000, 900, 180, 180, 1313442900, 900, 180, 180, 1313443800, 900, 179, 179, 1313444700, 900, 180, 180, 1313445600, 900, 180, 180, 1313446500, 900, 180, 180, 1313447400, 900, 180, 180, 1313448300, 900, 180, 180, 1313449200, 900, 179, 179, 1313450100, 900, 180, 180, 1313451000, 900, 180, 180, 1313451900, 900, 180, 180, 1313452800, 900, 180, 180, 1313453700, 900, 179, 179, 1313454600, 900, 180, 180, 1313455500, 900, 180, 180, 1313456400, 900, 180, 180, 1313457300, 900, 180, 180, 1313458200, 900, 180, 180, 1313459100, 900, 179, 179, 1313460000, 900, 180, 180, 1313460900, 900, 180, 180, 1313461800, 900, 180, 180, 1313462700, 900, 180, 180, 1313463600, 900, 180, 180, 1313464500, 900, 179, 179, 1313465400, 900, 180, 180, 1313466300, 900, 180, 180, 1313467200, 900, 180, 180, 1313468100, 900, 180, 180, 1313469000, 900, 179, 179, 1313469900, 900, 180, 180, 1313470800, 900, 180, 180, 1313471700, 900, 180, 180, 1313472600, 900, 180, 180, 1313473500, 900, 179, 179, 1313474400, 900, 180, 180, 1313475300, 900, 180, 180, 1313476200, 900, 180, 180, 1313477100, 900, 180, 180, 1313478000, 900, 180, 180, 1313478900, 900, 179, 179, 1313479800, 900, 180, 180, 1313480700, 900, 180, 180, 1313481600, 900, 180, 180, 1313482500, 900, 180, 180, 1313483400, 900, 179, 179, 1313484300, 900, 180, 180, 1313485200, 900, 180, 180, 1313486100, 900, 180, 180, 1313487000, 900, 180, 180, 1313487900, 900, 180, 180, 1313488800, 900, 179, 179, 1313489700, 900, 180, 180, 1313490600, 900, 180, 180, 1313491500, 900, 180, 180, 1313492400, 900, 180, 180, 1313493300, 900, 180, 180, 1313494200, 900, 179, 179, 1313495100, 900, 180, 180, 1313496000, 900, 180, 180, 1313496900, 900, 180, 180, 1313497800, 900, 180, 180, 1313498700, 900, 180, 180, 1313499600, 900, 179, 179, 1313500500, 900, 180, 180, 1313501400, 900, 180, 180, 1313502300, 900, 180, 180, 1313503200, 900, 180, 180, 1313504100, 900, 179, 179, 1313505000, 900, 180, 180, 1313505900, 900, 180, 180, 1313506800, 900, 180, 180, 1313507700, 900, 180, 180, 1313508600, 900, 180, 180, 1313509500, 900, 179, 179, 1313510400, 900, 180, 180, 1313511300, 900, 180, 180, 1313512200, 900, 180, 180, 1313513100, 900, 180, 180, 1313514000, 900, 179, 179, 1313514900, 900, 180, 180, 1313515800, 900, 180, 180, 1313516700, 900, 180, 180, 1313517600, 900, 180, 180, 1313518500, 900, 180, 180, 1313519400, 900, 179, 179, 1313520300, 900, 180, 180, 1313521200, 900, 180, 180, 1313522100, 900, 180, 180, 1313523000, 900, 180, 180, 1313523900, 900, 180, 180, 1313524800, 900, 179, 179, 1313525700, 900, 180, 180, 1313526600, 900, 180, 180, 1313527500, 900, 180, 180, 1313528400, 900, 180, 180, 1313529300, 900, 179, 179, 1313530200, 900, 180, 180, 1313531100, 900, 180, 180, 1313532000, 900, 180, 180, 1313532900, 900, 180, 180, 1313533800, 900, 180, 180, 1313534700, 900, 179, 179, 1313535600, 900, 180, 180, 1313536500, 900, 180, 180, 1313537400, 900, 180, 180, 1313538300, 900, 180, 180, 1313539200, 900, 179, 179, 1313540100, 900, 180, 180, 1313541000, 900, 180, 180, 1313541900, 900, 180, 180, 1313542800, 900, 180, 180, 1313543700, 900, 179, 179, 1313544600, 900, 180, 180, 1313545500, 900, 180, 180, 1313546400, 900, 180, 180, 1313547300, 900, 180, 180, 1313548200, 900, 180, 180, 1313549100, 900, 179, 179, 1313550000, 900, 180, 180, 1313550900, 900, 180, 180, 1313551800, 900, 180, 180, 1313552700, 900, 180, 180, 1313553600, 900, 179, 179, 1313554500, 900, 180, 180, 1313555400, 900, 180, 180, 1313556300, 900, 180, 180, 1313557200, 900, 180, 180, 1313558100, 900, 180, 180, 1313559000, 900, 179, 179, 1313559900, 900, 180, 180, 1313560800, 900, 180, 180, 1313561700, 900, 180, 180, 1313562600, 900, 180, 180, 1313563500, 900, 180, 180, 1313564400, 900, 179, 179, 1313565300, 900, 180, 180, 1313566200, 900, 180, 180, 1313567100, 900, 180, 180, 1313568000, 900, 180, 180, 1313568900, 900, 179, 179, 1313569800, 900, 180, 180, 1313570700, 900, 180, 180, 1313571600, 900, 180, 180, 1313572500, 900, 180, 180, 1313573400, 900, 180, 180, 1313574300, 900, 179, 179, 1313575200, 900, 180, 180, 1313576100, 900, 180, 180, 1313577000, 900, 180, 180, 1313577900, 900, 180, 180, 1313578800, 900, 180, 180, 1313579700, 900, 179, 179, 1313580600, 900, 180, 180, 1313581500, 900, 180, 180, 1313582400, 900, 180, 180, 1313583300, 900, 180, 180, 1313584200, 900, 179, 179, 1313585100, 900, 180, 180, 1313586000, 900, 180, 180, 1313586900, 900, 180, 180, 1313587800, 900, 180, 180, 1313588700, 900, 180, 180, 1313589600, 900, 179, 179, 1313590500, 900, 180, 180, 1313591400, 900, 180, 180, 1313592300, 900, 180, 180, 1313593200, 900, 180, 180, 1313594100, 900, 180, 180, 1313595000, 900, 179, 179, 1313595900, 900, 180, 180, 1313596800, 900, 180, 180, 1313597700, 900, 180, 180, 1313598600, 900, 180, 180, 1313599500, 900, 179, 179, 1313600400, 900, 180, 180, 1313601300, 900, 180, 180, 1313602200, 900, 180, 180, 1313603100, 900, 180, 180, 1313604000, 900, 180, 180, 1313604900, 900, 179, 179, 1313605800, 900, 180, 180, 1313606700, 900, 180, 180, 1313607600, 900, 180, 180, 1313608500, 900, 180, 180, 1313609400, 900, 180, 180, 1313610300, 900, 179, 179, 1313611200, 900, 180, 180, 1313612100, 900, 180, 180, 1313613000, 900, 180, 180, 1313613900, 900, 180, 180, 1313614800, 900, 179, 179, 1313615700, 900, 180, 180, 1313616600, 900, 180, 180, 1313617500, 900, 180, 180, 1313618400, 900, 180, 180, 1313619300, 900, 179, 179, 1313620200, 900, 180, 180, 1313621100, 900, 180, 180, 1313622000, 900, 180, 180, 1313622900, 900, 180, 180, 1313623800, 900, 180, 180, 1313624700, 900, 179, 179, 1313625600, 900, 180, 180, 1313626500, 900, 180, 180, 1313627400, 900, 180, 180, 1313628300, 900, 180, 180, 1313629200, 900, 180, 180, 1313630100, 900, 179, 179, 1313631000, 900, 180, 180, 1313631900, 900, 180, 180, 1313632800, 900, 180, 180, 1313633700, 900, 180, 180, 1313634600, 900, 179, 179, 1313635500, 900, 180, 180, 1313636400, 900, 180, 180, 1313637300, 900, 180, 180, 1313638200, 900, 180, 180, 1313639100, 900, 180, 180, 1313640000, 900, 179, 179, 1313640900, 900, 180, 180, 1313641800, 900, 180, 180, 1313642700, 900, 180, 180, 1313643600, 900, 180, 180, 1313644500, 900, 180, 180, 1313645400, 900, 179, 179, 1313646300, 900, 180, 180, 1313647200, 900, 180, 180, 1313648100, 900, 180, 180, 1313649000, 900, 180, 180, 1313649900, 900, 179, 179, 1313650800, 900, 180, 180, 1313651700, 900, 180, 180, 1313652600, 900, 180, 180, 1313653500, 900, 180, 180, 1313654400, 900, 179, 179, 1313655300, 900, 180, 180, 1313656200, 900, 180, 180, 1313657100, 900, 180, 180, 1313658000, 900, 180, 180, 1313658900, 900, 179, 179, 1313659800, 900, 180, 180, 1313660700, 900, 180, 180, 1313661600, 900, 180, 180, 1313662500, 900, 180, 180, 1313663400, 900, 180, 180, 1313664300, 900, 179, 179, 1313665200, 900, 180, 180, 1313666100, 900, 180, 180, 1313667000, 900, 180, 180, 1313667900, 900, 180, 180, 1313668800, 900, 179, 179, 1313669700, 900, 180, 180, 1313670600, 900, 180, 180, 1313671500, 900, 180, 180, 1313672400, 900, 180, 180, 1313673300, 900, 180, 180, 1313674200, 900, 179, 179, 1313675100, 900, 180, 180, 1313676000, 900, 180, 180, 1313676900, 900, 180, 180, 1313677800, 900, 180, 180, 1313678700, 900, 180, 180, 1313679600, 900, 179, 179, 1313680500, 900, 180, 180, 1313681400, 900, 180, 180, 1313682300, 900, 180, 180, 1313683200, 900, 180, 180, 1313684100, 900, 180, 180, 1313685000, 900, 179, 179, 1313685900, 900, 180, 180, 1313686800, 900, 180, 180, 1313687700, 900, 180, 180, 1313688600, 900, 180, 180, 1313689500, 900, 179, 179, 1313690400, 900, 180, 180, 1313691300, 900, 180, 180, 1313692200, 900, 180, 180, 1313693100, 900, 180, 180, 1313694000, 900, 179, 179, 1313694900, 900, 180, 180, 1313695800, 900, 180, 180, 1313696700, 900, 180, 180, 1313697600, 900, 180, 180, 1313698500, 900, 180, 180, 1313699400, 900, 179, 179, 1313700300, 900, 180, 180, 1313701200, 900, 180, 180, 1313702100, 900, 180, 180, 1313703000, 900, 180, 180, 1313703900, 900, 179, 179, 1313704800, 900, 180, 180, 1313705700, 900, 180, 180, 1313706600, 900, 180, 180, 1313707500, 900, 180, 180, 1313708400, 900, 180, 180, 1313709300, 900, 179, 179, 1313710200, 900, 180, 180, 1313711100, 900, 180, 180, 1313712000, 900, 180, 180, 1313712900, 900, 180, 180, 1313713800, 900, 180, 180, 1313714700, 900, 179, 179, 1313715600, 900, 180, 180, 1313716500, 900, 180, 180, 1313717400, 900, 180, 180, 1313718300, 900, 180, 180, 1313719200, 900, 179, 179, 1313720100, 900, 180, 180, 1313721000, 900, 180, 180, 1313721900, 900, 180, 180, 1313722800, 900, 180, 180, 1313723700, 900, 180, 180, 1313724600, 900, 179, 179, 1313725500, 900, 180, 180, 1313726400, 900, 180, 180, 1313727300, 900, 180, 180, 1313728200, 900, 180, 180, 1313729100, 900, 179, 179, 1313730000, 900, 180, 180, 1313730900, 900, 180, 180, 1313731800, 900, 180, 180, 1313732700, 900, 180, 180, 1313733600, 900, 180, 180, 1313734500, 900, 179, 179, 1313735400, 900, 180, 180, 1313736300, 900, 180, 180, 1313737200, 900, 180, 180, 1313738100, 900, 180, 180, 1313739000, 900, 179, 179, 1313739900, 900, 180, 180, 1313740800, 900, 180, 180, 1313741700, 900, 180, 180, 1313742600, 900, 180, 180, 1313743500, 900, 180, 180, 1313744400, 900, 179, 179, 1313745300, 900, 180, 180, 1313746200, 900, 180, 180, 1313747100, 900, 180, 180, 1313748000, 900, 180, 180, 1313748900, 900, 180, 180, 1313749800, 900, 179, 179, 1313750700, 900, 180, 180, 1313751600, 900, 180, 180, 1313752500, 900, 180, 180, 1313753400, 900, 180, 180, 1313754300, 900, 179, 179, 1313755200, 900, 180, 180, 1313756100, 900, 180, 180, 1313757000, 900, 180, 180, 1313757900, 900, 180, 180, 1313758800, 900, 180, 180, 1313759700, 900, 179, 179, 1313760600, 900, 180, 180, 1313761500, 900, 180, 180, 1313762400, 900, 180, 180, 1313763300, 900, 180, 180, 1313764200, 900, 180, 180, 1313765100, 900, 179, 179, 1313766000, 900, 180, 180, 1313766900, 900, 180, 180, 1313767800, 900, 180, 180, 1313768700, 900, 180, 180, 1313769600, 900, 179, 179, 1313770500, 900, 180, 180, 1313771400, 900, 180, 180, 1313772300, 900, 180, 180, 1313773200, 900, 180, 180, 1313774100, 900, 180, 180, 1313775000, 900, 179, 179, 1313775900, 900, 180, 180, 1313776800, 900, 180, 180, 1313777700, 900, 180, 180, 1313778600, 900, 180, 180, 1313779500, 900, 180, 180, 1313780400, 900, 179, 179, 1313781300, 900, 180, 180, 1313782200, 900, 180, 180, 1313783100, 900, 180, 180, 1313784000, 900, 180, 180, 1313784900, 900, 179, 179, 1313785800, 900, 180, 180, 1313786700, 900, 180, 180, 1313787600, 900, 180, 180, 1313788500, 900, 180, 180, 1313789400, 900, 180, 180, 1313790300, 900, 179, 179, 1313791200, 900, 180, 180, 1313792100, 900, 180, 180, 1313793000, 900, 180, 180, 1313793900, 900, 180, 180, 1313794800, 900, 179, 179, 1313795700, 900, 180, 180, 1313796600, 900, 180, 180, 1313797500, 900, 180, 180, 1313798400, 900, 180, 180, 1313799300, 900, 180, 180, 1313800200, 900, 179, 179, 1313801100, 900, 180, 180, 1313802000, 900, 180, 180, 1313802900, 900, 180, 180, 1313803800, 900, 180, 180, 1313804700, 900, 180, 180, 1313805600, 900, 179, 179, 1313806500, 900, 180, 180, 1313807400, 900, 180, 180, 1313808300, 900, 180, 180, 1313809200, 900, 180, 180, 1313810100, 900, 179, 179, 1313811000, 900, 180, 180, 1313811900, 900, 180, 180, 1313812800, 900, 180, 180, 1313813700, 900, 180, 180, 1313814600, 900, 180, 180, 1313815500, 900, 179, 179, 1313816400, 900, 180, 180, 1313817300, 900, 180, 180, 1313818200, 900, 180, 180, 1313819100, 900, 180, 180, 1313820000, 900, 179, 179, 1313820900, 900, 180, 180, 1313821800, 900, 180, 180, 1313822700, 900, 180, 180, 1313823600, 900, 180, 180, 1313824500, 900, 180, 180, 1313825400, 900, 179, 179, 1313826300, 900, 180, 180, 1313827200, 900, 180, 180, 1313828100, 900, 180, 180, 1313829000, 900, 180, 180, 1313829900, 900, 180, 180, 1313830800, 900, 179, 179, 1313831700, 900, 180, 180, 1313832600, 900, 180, 180, 1313833500, 900, 180, 180, 1313834400, 900, 180, 180, 1313835300, 900, 179, 179, 1313836200, 900, 180, 180, 1313837100, 900, 180, 180, 1313838000, 900, 180, 180, 1313838900, 900, 180, 180, 1313839800, 900, 180, 180, 1313840700, 900, 179, 179, 1313841600, 900, 180, 180, 1313842500, 900, 180, 180, 1313843400, 900, 180, 180, 1313844300, 900, 180, 180, 1313845200, 900, 179, 179, 1313846100, 900, 180, 180, 1313847000, 900, 180, 180, 1313847900, 900, 180, 180, 1313848800, 900, 180, 180, 1313849700, 900, 180, 180, 1313850600, 900, 179, 179, 1313851500, 900, 180, 180, 1313852400, 900, 180, 180, 1313853300, 900, 180, 180, 1313854200, 900, 180, 180, 1313855100, 900, 180, 180, 1313856000, 900, 179, 179, 1313856900, 900, 180, 180, 1313857800, 900, 180, 180, 1313858700, 900, 180, 180, 1313859600, 900, 180, 180, 1313860500, 900, 179, 179, 1313861400, 900, 180, 180, 1313862300, 900, 180, 180, 1313863200, 900, 180, 180, 1313864100, 900, 180, 180, 1313865000, 900, 180, 180, 1313865900, 900, 179, 179, 1313866800, 900, 180, 180, 1313867700, 900, 180, 180, 1313868600, 900, 180, 180, 1313869500, 900, 180, 180, 1313870400, 900, 179, 179, 1313871300, 900, 180, 180, 1313872200, 900, 180, 180, 1313873100, 900, 180, 180, 1313874000, 900, 180, 180, 1313874900, 900, 179, 179, 1313875800, 900, 180, 180, 1313876700, 900, 180, 180, 1313877600, 900, 180, 180, 1313878500, 900, 180, 180, 1313879400, 900, 180, 180, 1313880300, 900, 179, 179, 1313881200, 900, 180, 180, 1313882100, 900, 180, 180, 1313883000, 900, 180, 180, 1313883900, 900, 180, 180, 1313884800, 900, 180, 180, 1313885700, 900, 179, 179, 1313886600, 900, 180, 180, 1313887500, 900, 180, 180, 1313888400, 900, 180, 180, 1313889300, 900, 180, 180, 1313890200, 900, 179, 179, 1313891100, 900, 180, 180, 1313892000, 900, 180, 180, 1313892900, 900, 180, 180, 1313893800, 900, 180, 180, 1313894700, 900, 180, 180, 1313895600, 900, 179, 179, 1313896500, 900, 180, 180, 1313897400, 900, 180, 180, 1313898300, 900, 180, 180, 1313899200, 900, 180, 180, 1313900100, 900, 179, 179, 1313901000, 900, 180, 180, 1313901900, 900, 180, 180, 1313902800, 900, 180, 180, 1313903700, 900, 180, 180, 1313904600, 900, 180, 180, 1313905500, 900, 179, 179, 1313906400, 900, 180, 180, 1313907300, 900, 180, 180, 1313908200, 900, 180, 180, 1313909100, 900, 180, 180, 1313910000, 900, 179, 179, 1313910900, 900, 180, 180, 1313911800, 900, 180, 180, 1313912700, 900, 180, 180, 1313913600, 900, 180, 180, 1313914500, 900, 180, 180, 1313915400, 900, 179, 179, 1313916300, 900, 180, 180, 1313917200, 900, 180, 180, 1313918100, 900, 180, 180, 1313919000, 900, 180, 180, 1313919900, 900, 180, 180, 1313920800, 900, 179, 179, 1313921700, 900, 180, 180, 1313922600, 900, 180, 180, 1313923500, 900, 180, 180, 1313924400, 900, 180, 180, 1313925300, 900, 179, 179, 1313926200, 900, 180, 180, 1313927100, 900, 180, 180, 1313928000, 900, 180, 180, 1313928900, 900, 180, 180, 1313929800, 900, 180, 180, 1313930700, 900, 179, 179, 1313931600, 900, 180, 180, 1313932500, 900, 180, 180, 1313933400, 900, 180, 180, 1313934300, 900, 180, 180, 1313935200, 900, 179, 179, 1313936100, 900, 180, 180, 1313937000, 900, 180, 180, 1313937900, 900, 180, 180, 1313938800, 900, 180, 180, 1313939700, 900, 179, 179, 1313940600, 900, 180, 180, 1313941500, 900, 180, 180, 1313942400, 900, 180, 180, 1313943300, 900, 180, 180, 1313944200, 900, 179, 179, 1313945100, 900, 180, 180, 1313946000, 900, 180, 180, 1313946900, 900, 180, 180, 1313947800, 900, 180, 180, 1313948700, 900, 180, 180, 1313949600, 900, 179, 179, 1313950500, 900, 180, 180, 1313951400, 900, 180, 180, 1313952300, 900, 180, 180, 1313953200, 900, 180, 180, 1313954100, 900, 180, 180, 1313955000, 900, 179, 179, 1313955900, 900, 180, 180, 1313956800, 900, 180, 180, 1313957700, 900, 180, 180, 1313958600, 900, 180, 180, 1313959500, 900, 179, 179, 1313960400, 900, 180, 180, 1313961300, 900, 180, 180, 1313962200, 900, 144, 144, 1313962920, 60, 12, 12, 1313962980, 60, 12, 12, 1313963040, 60, 12, 12, 1313963100, 60, 12, 12, 1313963160, 60, 12, 12, 1313963220, 60, 12, 12, 1313963280, 60, 12, 12, 1313963340, 60, 12, 12, 1313963400, 60, 12, 12, 1313963460, 60, 12, 12, 1313963520, 60, 12, 12, 1313963580, 60, 12, 12, 1313963640, 60, 12, 12, 1313963700, 60, 12, 12, 1313963760, 60, 12, 12, 1313963820, 60, 12, 12, 1313963880, 60, 12, 12, 1313963940, 60, 12, 12, 1313964000, 60, 12, 12, 1313964060, 60, 12, 12, 1313964120, 60, 12, 12, 1313964180, 60, 12, 12, 1313964240, 60, 12, 12, 1313964300, 60, 12, 12, 1313964360, 60, 12, 12, 1313964420, 60, 12, 12, 1313964480, 60, 12, 12, 1313964540, 60, 12, 12, 1313964600, 60, 12, 12, 1313964660, 60, 12, 12, 1313964720, 60, 12, 12, 1313964780, 60, 12, 12, 1313964840, 60, 12, 12, 1313964900, 60, 12, 12, 1313964960, 60, 12, 12, 1313965020, 60, 12, 12, 1313965080, 60, 12, 12, 1313965140, 60, 11, 11, 1313965200, 60, 12, 12, 1313965260, 60, 12, 12, 1313965320, 60, 12, 12, 1313965380, 60, 12, 12, 1313965440, 60, 12, 12, 1313965500, 60, 12, 12, 1313965560, 60, 12, 12, 1313965620, 60, 12, 12, 1313965680, 60, 12, 12, 1313965740, 60, 12, 12, 1313965800, 60, 12, 12, 1313965860, 60, 12, 12, 1313965920, 60, 12, 12, 1313965980, 60, 12, 12, 1313966040, 60, 12, 12, 1313966100, 60, 12, 12, 1313966160, 60, 12, 12, 1313966220, 60, 12, 12, 1313966280, 60, 12, 12, 1313966340, 60, 12, 12, 1313966400, 60, 12, 12, 1313966460, 60, 12, 12, 1313966520, 60, 12, 12, 1313966580, 60, 12, 12, 1313966640, 60, 12, 12, 1313966700, 60, 12, 12, 1313966760, 60, 12, 12, 1313966820, 60, 12, 12, 1313966880, 60, 12, 12, 1313966940, 60, 12, 12, 1313967000, 60, 12, 12, 1313967060, 60, 12, 12, 1313967120, 60, 12, 12, 1313967180, 60, 12, 12, 1313967240, 60, 12, 12, 1313967300, 60, 12, 12, 1313967360, 60, 12, 12, 1313967420, 60, 12, 12, 1313967480, 60, 12, 12, 1313967540, 60, 12, 12, 1313967600, 60, 12, 12, 1313967660, 60, 12, 12, 1313967720, 60, 12, 12, 1313967780, 60, 12, 12, 1313967840, 60, 12, 12, 1313967900, 60, 12, 12, 1313967960, 60, 12, 12, 1313968020, 60, 12, 12, 1313968080, 60, 12, 12, 1313968140, 60, 12, 12, 1313968200, 60, 12, 12, 1313968260, 60, 12, 12, 1313968320, 60, 12, 12, 1313968380, 60, 12, 12, 1313968440, 60, 12, 12, 1313968500, 60, 12, 12, 1313968560, 60, 12, 12, 1313968620, 60, 12, 12, 1313968680, 60, 12, 12, 1313968740, 60, 12, 12, 1313968800, 60, 12, 12, 1313968860, 60, 12, 12, 1313968920, 60, 12, 12, 1313968980, 60, 12, 12, 1313969040, 60, 12, 12, 1313969100, 60, 12, 12, 1313969160, 60, 12, 12, 1313969220, 60, 12, 12, 1313969280, 60, 12, 12, 1313969340, 60, 12, 12, 1313969400, 60, 12, 12, 1313969460, 60, 12, 12, 1313969520, 60, 12, 12, 1313969580, 60, 12, 12, 1313969640, 60, 12, 12, 1313969700, 60, 12, 12, 1313969760, 60, 12, 12, 1313969820, 60, 12, 12, 1313969880, 60, 12, 12, 1313969940, 60, 12, 12, 1313970000, 60, 12, 12, 1313970060, 60, 12, 12, 1313970120, 60, 12, 12, 1313970180, 60, 11, 11, 1313970240, 60, 12, 12, 1313970300, 60, 12, 12, 1313970360, 60, 12, 12, 1313970420, 60, 12, 12, 1313970480, 60, 12, 12, 1313970540, 60, 12, 12, 1313970600, 60, 12, 12, 1313970660, 60, 12, 12, 1313970720, 60, 12, 12, 1313970780, 60, 12, 12, 1313970840, 60, 12, 12, 1313970900, 60, 12, 12, 1313970960, 60, 12, 12, 1313971020, 60, 12, 12, 1313971080, 60, 12, 12, 1313971140, 60, 12, 12, 1313971200, 60, 12, 12, 1313971260, 60, 12, 12, 1313971320, 60, 12, 12, 1313971380, 60, 12, 12, 1313971440, 60, 12, 12, 1313971500, 60, 12, 12, 1313971560, 60, 12, 12, 1313971620, 60, 12, 12, 1313971680, 60, 12, 12, 1313971740, 60, 12, 12, 1313971800, 60, 12, 12, 1313971860, 60, 12, 12, 1313971920, 60, 12, 12, 1313971980, 60, 12, 12, 1313972040, 60, 12, 12, 1313972100, 60, 12, 12, 1313972160, 60, 12, 12, 1313972220, 60, 12, 12, 1313972280, 60, 12, 12, 1313972340, 60, 12, 12, 1313972400, 60, 12, 12, 1313972460, 60, 12, 12, 1313972520, 60, 12, 12, 1313972580, 60, 12, 12, 1313972640, 60, 12, 12, 1313972700, 60, 12, 12, 1313972760, 60, 12, 12, 1313972820, 60, 12, 12, 1313972880, 60, 12, 12, 1313972940, 60, 12, 12, 1313973000, 60, 12, 12, 1313973060, 60, 12, 12, 1313973120, 60, 12, 12, 1313973180, 60, 12, 12, 1313973240, 60, 12, 12, 1313973300, 60, 12, 12, 1313973360, 60, 12, 12, 1313973420, 60, 12, 12, 1313973480, 60, 12, 12, 1313973540, 60, 12, 12, 1313973600, 60, 12, 12, 1313973660, 60, 12, 12, 1313973720, 60, 12, 12, 1313973780, 60, 12, 12, 1313973840, 60, 12, 12, 1313973900, 60, 12, 12, 1313973960, 60, 12, 12, 1313974020, 60, 12, 12, 1313974080, 60, 12, 12, 1313974140, 60, 12, 12, 1313974200, 60, 12, 12, 1313974260, 60, 12, 12, 1313974320, 60, 12, 12, 1313974380, 60, 12, 12, 1313974440, 60, 12, 12, 1313974500, 60, 12, 12, 1313974560, 60, 12, 12, 1313974620, 60, 12, 12, 1313974680, 60, 12, 12, 1313974740, 60, 12, 12, 1313974800, 60, 12, 12, 1313974860, 60, 12, 12, 1313974920, 60, 12, 12, 1313974980, 60, 12, 12, 1313975040, 60, 12, 12, 1313975100, 60, 12, 12, 1313975160, 60, 12, 12, 1313975220, 60, 11, 11, 1313975280, 60, 12, 12, 1313975340, 60, 12, 12, 1313975400, 60, 12, 12, 1313975460, 60, 12, 12, 1313975520, 60, 12, 12, 1313975580, 60, 12, 12, 1313975640, 60, 12, 12, 1313975700, 60, 12, 12, 1313975760, 60, 12, 12, 1313975820, 60, 12, 12, 1313975880, 60, 12, 12, 1313975940, 60, 12, 12, 1313976000, 60, 12, 12, 1313976060, 60, 12, 12, 1313976120, 60, 12, 12, 1313976180, 60, 12, 12, 1313976240, 60, 12, 12, 1313976300, 60, 12, 12, 1313976360, 60, 12, 12, 1313976420, 60, 12, 12, 1313976480, 60, 12, 12, 1313976540, 60, 12, 12, 1313976600, 60, 12, 12, 1313976660, 60, 12, 12, 1313976720, 60, 12, 12, 1313976780, 60, 12, 12, 1313976840, 60, 12, 12, 1313976900, 60, 12, 12, 1313976960, 60, 12, 12, 1313977020, 60, 12, 12, 1313977080, 60, 12, 12, 1313977140, 60, 12, 12, 1313977200, 60, 12, 12, 1313977260, 60, 12, 12, 1313977320, 60, 12, 12, 1313977380, 60, 12, 12, 1313977440, 60, 12, 12, 1313977500, 60, 12, 12, 1313977560, 60, 12, 12, 1313977620, 60, 12, 12, 1313977680, 60, 12, 12, 1313977740, 60, 12, 12, 1313977800, 60, 12, 12, 1313977860, 60, 12, 12, 1313977920, 60, 12, 12, 1313977980, 60, 12, 12, 1313978040, 60, 12, 12, 1313978100, 60, 12, 12, 1313978160, 60, 12, 12, 1313978220, 60, 12, 12, 1313978280, 60, 12, 12, 1313978340, 60, 12, 12, 1313978400, 60, 12, 12, 1313978460, 60, 12, 12, 1313978520, 60, 12, 12, 1313978580, 60, 12, 12, 1313978640, 60, 12, 12, 1313978700, 60, 12, 12, 1313978760, 60, 12, 12, 1313978820, 60, 12, 12, 1313978880, 60, 12, 12, 1313978940, 60, 12, 12, 1313979000, 60, 12, 12, 1313979060, 60, 12, 12, 1313979120, 60, 12, 12, 1313979180, 60, 12, 12, 1313979240, 60, 12, 12, 1313979300, 60, 12, 12, 1313979360, 60, 12, 12, 1313979420, 60, 12, 12, 1313979480, 60, 12, 12, 1313979540, 60, 12, 12, 1313979600, 60, 12, 12, 1313979660, 60, 12, 12, 1313979720, 60, 12, 12, 1313979780, 60, 12, 12, 1313979840, 60, 12, 12, 1313979900, 60, 12, 12, 1313979960, 60, 12, 12, 1313980020, 60, 12, 12, 1313980080, 60, 12, 12, 1313980140, 60, 12, 12, 1313980200, 60, 12, 12, 1313980260, 60, 11, 11, 1313980320, 60, 12, 12, 1313980380, 60, 12, 12, 1313980440, 60, 12, 12, 1313980500, 60, 12, 12, 1313980560, 60, 12, 12, 1313980620, 60, 12, 12, 1313980680, 60, 12, 12, 1313980740, 60, 12, 12, 1313980800, 60, 12, 12, 1313980860, 60, 12, 12, 1313980920, 60, 12, 12, 1313980980, 60, 12, 12, 1313981040, 60, 12, 12, 1313981100, 60, 12, 12, 1313981160, 60, 12, 12, 1313981220, 60, 12, 12, 1313981280, 60, 12, 12, 1313981340, 60, 12, 12, 1313981400, 60, 12, 12, 1313981460, 60, 12, 12, 1313981520, 60, 12, 12, 1313981580, 60, 12, 12, 1313981640, 60, 12, 12, 1313981700, 60, 12, 12, 1313981760, 60, 12, 12, 1313981820, 60, 12, 12, 1313981880, 60, 12, 12, 1313981940, 60, 12, 12, 1313982000, 60, 12, 12, 1313982060, 60, 12, 12, 1313982120, 60, 12, 12, 1313982180, 60, 12, 12, 1313982240, 60, 12, 12, 1313982300, 60, 12, 12, 1313982360, 60, 12, 12, 1313982420, 60, 12, 12, 1313982480, 60, 12, 12, 1313982540, 60, 12, 12, 1313982600, 60, 12, 12, 1313982660, 60, 12, 12, 1313982720, 60, 12, 12, 1313982780, 60, 12, 12, 1313982840, 60, 12, 12, 1313982900, 60, 12, 12, 1313982960, 60, 12, 12, 1313983020, 60, 12, 12, 1313983080, 60, 12, 12, 1313983140, 60, 12, 12, 1313983200, 60, 12, 12, 1313983260, 60, 12, 12, 1313983320, 60, 12, 12, 1313983380, 60, 12, 12, 1313983440, 60, 12, 12, 1313983500, 60, 12, 12, 1313983560, 60, 12, 12, 1313983620, 60, 12, 12, 1313983680, 60, 12, 12, 1313983740, 60, 12, 12, 1313983800, 60, 12, 12, 1313983860, 60, 12, 12, 1313983920, 60, 12, 12, 1313983980, 60, 12, 12, 1313984040, 60, 12, 12, 1313984100, 60, 12, 12, 1313984160, 60, 12, 12, 1313984220, 60, 12, 12, 1313984280, 60, 12, 12, 1313984340, 60, 12, 12, 1313984400, 60, 12, 12, 1313984460, 60, 12, 12, 1313984520, 60, 12, 12, 1313984580, 60, 12, 12, 1313984640, 60, 12, 12, 1313984700, 60, 12, 12, 1313984760, 60, 12, 12, 1313984820, 60, 12, 12, 1313984880, 60, 12, 12, 1313984940, 60, 12, 12, 1313985000, 60, 12, 12, 1313985060, 60, 12, 12, 1313985120, 60, 12, 12, 1313985180, 60, 12, 12, 1313985240, 60, 12, 12, 1313985300, 60, 11, 11, 1313985360, 60, 12, 12, 1313985420, 60, 12, 12, 1313985480, 60, 12, 12, 1313985540, 60, 12, 12, 1313985600, 60, 12, 12, 1313985660, 60, 12, 12, 1313985720, 60, 12, 12, 1313985780, 60, 12, 12, 1313985840, 60, 12, 12, 1313985900, 60, 12, 12, 1313985960, 60, 12, 12, 1313986020, 60, 12, 12, 1313986080, 60, 12, 12, 1313986140, 60, 12, 12, 1313986200, 60, 12, 12, 1313986260, 60, 12, 12, 1313986320, 60, 12, 12, 1313986380, 60, 12, 12, 1313986440, 60, 12, 12, 1313986500, 60, 12, 12, 1313986560, 60, 12, 12, 1313986620, 60, 12, 12, 1313986680, 60, 12, 12, 1313986740, 60, 12, 12, 1313986800, 60, 12, 12, 1313986860, 60, 12, 12, 1313986920, 60, 12, 12, 1313986980, 60, 12, 12, 1313987040, 60, 12, 12, 1313987100, 60, 12, 12, 1313987160, 60, 12, 12, 1313987220, 60, 12, 12, 1313987280, 60, 12, 12, 1313987340, 60, 12, 12, 1313987400, 60, 12, 12, 1313987460, 60, 12, 12, 1313987520, 60, 12, 12, 1313987580, 60, 12, 12, 1313987640, 60, 12, 12, 1313987700, 60, 12, 12, 1313987760, 60, 12, 12, 1313987820, 60, 12, 12, 1313987880, 60, 12, 12, 1313987940, 60, 12, 12, 1313988000, 60, 12, 12, 1313988060, 60, 12, 12, 1313988120, 60, 12, 12, 1313988180, 60, 12, 12, 1313988240, 60, 12, 12, 1313988300, 60, 12, 12, 1313988360, 60, 12, 12, 1313988420, 60, 12, 12, 1313988480, 60, 12, 12, 1313988540, 60, 12, 12, 1313988600, 60, 12, 12, 1313988660, 60, 12, 12, 1313988720, 60, 12, 12, 1313988780, 60, 12, 12, 1313988840, 60, 12, 12, 1313988900, 60, 12, 12, 1313988960, 60, 12, 12, 1313989020, 60, 12, 12, 1313989080, 60, 12, 12, 1313989140, 60, 12, 12, 1313989200, 60, 12, 12, 1313989260, 60, 12, 12, 1313989320, 60, 12, 12, 1313989380, 60, 12, 12, 1313989440, 60, 12, 12, 1313989500, 60, 12, 12, 1313989560, 60, 12, 12, 1313989620, 60, 12, 12, 1313989680, 60, 12, 12, 1313989740, 60, 12, 12, 1313989800, 60, 12, 12, 1313989860, 60, 12, 12, 1313989920, 60, 12, 12, 1313989980, 60, 12, 12, 1313990040, 60, 12, 12, 1313990100, 60, 12, 12, 1313990160, 60, 12, 12, 1313990220, 60, 12, 12, 1313990280, 60, 12, 12, 1313990340, 60, 11, 11, 1313990400, 60, 12, 12, 1313990460, 60, 12, 12, 1313990520, 60, 12, 12, 1313990580, 60, 12, 12, 1313990640, 60, 12, 12, 1313990700, 60, 12, 12, 1313990760, 60, 12, 12, 1313990820, 60, 12, 12, 1313990880, 60, 12, 12, 1313990940, 60, 12, 12, 1313991000, 60, 12, 12, 1313991060, 60, 12, 12, 1313991120, 60, 12, 12, 1313991180, 60, 12, 12, 1313991240, 60, 12, 12, 1313991300, 60, 12, 12, 1313991360, 60, 12, 12, 1313991420, 60, 12, 12, 1313991480, 60, 12, 12, 1313991540, 60, 12, 12, 1313991600, 60, 12, 12, 1313991660, 60, 12, 12, 1313991720, 60, 12, 12, 1313991780, 60, 12, 12, 1313991840, 60, 12, 12, 1313991900, 60, 12, 12, 1313991960, 60, 12, 12, 1313992020, 60, 12, 12, 1313992080, 60, 12, 12, 1313992140, 60, 12, 12, 1313992200, 60, 12, 12, 1313992260, 60, 12, 12, 1313992320, 60, 12, 12, 1313992380, 60, 12, 12, 1313992440, 60, 12, 12, 1313992500, 60, 12, 12, 1313992560, 60, 12, 12, 1313992620, 60, 12, 12, 1313992680, 60, 12, 12, 1313992740, 60, 12, 12, 1313992800, 60, 12, 12, 1313992860, 60, 12, 12, 1313992920, 60, 12, 12, 1313992980, 60, 12, 12, 1313993040, 60, 12, 12, 1313993100, 60, 12, 12, 1313993160, 60, 12, 12, 1313993220, 60, 12, 12, 1313993280, 60, 12, 12, 1313993340, 60, 12, 12, 1313993400, 60, 12, 12, 1313993460, 60, 12, 12, 1313993520, 60, 12, 12, 1313993580, 60, 12, 12, 1313993640, 60, 12, 12, 1313993700, 60, 12, 12, 1313993760, 60, 12, 12, 1313993820, 60, 12, 12, 1313993880, 60, 12, 12, 1313993940, 60, 12, 12, 1313994000, 60, 12, 12, 1313994060, 60, 12, 12, 1313994120, 60, 12, 12, 1313994180, 60, 12, 12, 1313994240, 60, 12, 12, 1313994300, 60, 12, 12, 1313994360, 60, 12, 12, 1313994420, 60, 12, 12, 1313994480, 60, 12, 12, 1313994540, 60, 12, 12, 1313994600, 60, 12, 12, 1313994660, 60, 12, 12, 1313994720, 60, 12, 12, 1313994780, 60, 12, 12, 1313994840, 60, 12, 12, 1313994900, 60, 12, 12, 1313994960, 60, 12, 12, 1313995020, 60, 12, 12, 1313995080, 60, 12, 12, 1313995140, 60, 12, 12, 1313995200, 60, 12, 12, 1313995260, 60, 12, 12, 1313995320, 60, 12, 12, 1313995380, 60, 11, 11, 1313995440, 60, 12, 12, 1313995500, 60, 12, 12, 1313995560, 60, 12, 12, 1313995620, 60, 12, 12, 1313995680, 60, 12, 12, 1313995740, 60, 12, 12, 1313995800, 60, 12, 12, 1313995860, 60, 12, 12, 1313995920, 60, 12, 12, 1313995980, 60, 12, 12, 1313996040, 60, 12, 12, 1313996100, 60, 12, 12, 1313996160, 60, 12, 12, 1313996220, 60, 12, 12, 1313996280, 60, 12, 12, 1313996340, 60, 12, 12, 1313996400, 60, 12, 12, 1313996460, 60, 12, 12, 1313996520, 60, 12, 12, 1313996580, 60, 12, 12, 1313996640, 60, 12, 12, 1313996700, 60, 12, 12, 1313996760, 60, 12, 12, 1313996820, 60, 12, 12, 1313996880, 60, 12, 12, 1313996940, 60, 12, 12, 1313997000, 60, 12, 12, 1313997060, 60, 12, 12, 1313997120, 60, 12, 12, 1313997180, 60, 12, 12, 1313997240, 60, 12, 12, 1313997300, 60, 12, 12, 1313997360, 60, 12, 12, 1313997420, 60, 12, 12, 1313997480, 60, 12, 12, 1313997540, 60, 12, 12, 1313997600, 60, 12, 12, 1313997660, 60, 12, 12, 1313997720, 60, 12, 12, 1313997780, 60, 12, 12, 1313997840, 60, 12, 12, 1313997900, 60, 12, 12, 1313997960, 60, 12, 12, 1313998020, 60, 12, 12, 1313998080, 60, 12, 12, 1313998140, 60, 12, 12, 1313998200, 60, 12, 12, 1313998260, 60, 12, 12, 1313998320, 60, 12, 12, 1313998380, 60, 12, 12, 1313998440, 60, 12, 12, 1313998500, 60, 12, 12, 1313998560, 60, 12, 12, 1313998620, 60, 12, 12, 1313998680, 60, 12, 12, 1313998740, 60, 12, 12, 1313998800, 60, 12, 12, 1313998860, 60, 12, 12, 1313998920, 60, 12, 12, 1313998980, 60, 12, 12, 1313999040, 60, 12, 12, 1313999100, 60, 12, 12, 1313999160, 60, 12, 12, 1313999220, 60, 12, 12, 1313999280, 60, 12, 12, 1313999340, 60, 12, 12, 1313999400, 60, 12, 12, 1313999460, 60, 12, 12, 1313999520, 60, 12, 12, 1313999580, 60, 12, 12, 1313999640, 60, 12, 12, 1313999700, 60, 12, 12, 1313999760, 60, 12, 12, 1313999820, 60, 12, 12, 1313999880, 60, 12, 12, 1313999940, 60, 12, 12, 1314000000, 60, 12, 12, 1314000060, 60, 12, 12, 1314000120, 60, 12, 12, 1314000180, 60, 12, 12, 1314000240, 60, 12, 12, 1314000300, 60, 12, 12, 1314000360, 60, 12, 12, 1314000420, 60, 11, 11, 1314000480, 60, 12, 12, 1314000540, 60, 12, 12, 1314000600, 60, 12, 12, 1314000660, 60, 12, 12, 1314000720, 60, 12, 12, 1314000780, 60, 12, 12, 1314000840, 60, 12, 12, 1314000900, 60, 12, 12, 1314000960, 60, 12, 12, 1314001020, 60, 12, 12, 1314001080, 60, 12, 12, 1314001140, 60, 12, 12, 1314001200, 60, 12, 12, 1314001260, 60, 12, 12, 1314001320, 60, 12, 12, 1314001380, 60, 12, 12, 1314001440, 60, 12, 12, 1314001500, 60, 12, 12, 1314001560, 60, 12, 12, 1314001620, 60, 12, 12, 1314001680, 60, 12, 12, 1314001740, 60, 12, 12, 1314001800, 60, 12, 12, 1314001860, 60, 12, 12, 1314001920, 60, 12, 12, 1314001980, 60, 12, 12, 1314002040, 60, 12, 12, 1314002100, 60, 12, 12, 1314002160, 60, 12, 12, 1314002220, 60, 12, 12, 1314002280, 60, 12, 12, 1314002340, 60, 12, 12, 1314002400, 60, 12, 12, 1314002460, 60, 12, 12, 1314002520, 60, 12, 12, 1314002580, 60, 12, 12, 1314002640, 60, 12, 12, 1314002700, 60, 12, 12, 1314002760, 60, 12, 12, 1314002820, 60, 12, 12, 1314002880, 60, 12, 12, 1314002940, 60, 12, 12, 1314003000, 60, 12, 12, 1314003060, 60, 12, 12, 1314003120, 60, 12, 12, 1314003180, 60, 12, 12, 1314003240, 60, 12, 12, 1314003300, 60, 12, 12, 1314003360, 60, 12, 12, 1314003420, 60, 12, 12, 1314003480, 60, 12, 12, 1314003540, 60, 12, 12, 1314003600, 60, 12, 12, 1314003660, 60, 12, 12, 1314003720, 60, 12, 12, 1314003780, 60, 12, 12, 1314003840, 60, 12, 12, 1314003900, 60, 12, 12, 1314003960, 60, 12, 12, 1314004020, 60, 12, 12, 1314004080, 60, 12, 12, 1314004140, 60, 12, 12, 1314004200, 60, 12, 12, 1314004260, 60, 12, 12, 1314004320, 60, 12, 12, 1314004380, 60, 12, 12, 1314004440, 60, 12, 12, 1314004500, 60, 12, 12, 1314004560, 60, 12, 12, 1314004620, 60, 12, 12, 1314004680, 60, 12, 12, 1314004740, 60, 12, 12, 1314004800, 60, 12, 12, 1314004860, 60, 12, 12, 1314004920, 60, 12, 12, 1314004980, 60, 12, 12, 1314005040, 60, 12, 12, 1314005100, 60, 12, 12, 1314005160, 60, 12, 12, 1314005220, 60, 12, 12, 1314005280, 60, 12, 12, 1314005340, 60, 12, 12, 1314005400, 60, 12, 12, 1314005460, 60, 12, 12, 1314005520, 60, 11, 11, 1314005580, 60, 12, 12, 1314005640, 60, 12, 12, 1314005700, 60, 12, 12, 1314005760, 60, 12, 12, 1314005820, 60, 12, 12, 1314005880, 60, 12, 12, 1314005940, 60, 12, 12, 1314006000, 60, 12, 12, 1314006060, 60, 12, 12, 1314006120, 60, 12, 12, 1314006180, 60, 12, 12, 1314006240, 60, 12, 12, 1314006300, 60, 12, 12, 1314006360, 60, 12, 12, 1314006420, 60, 12, 12, 1314006480, 60, 12, 12, 1314006540, 60, 12, 12, 1314006600, 60, 12, 12, 1314006660, 60, 12, 12, 1314006720, 60, 12, 12, 1314006780, 60, 12, 12, 1314006840, 60, 12, 12, 1314006900, 60, 12, 12, 1314006960, 60, 12, 12, 1314007020, 60, 12, 12, 1314007080, 60, 12, 12, 1314007140, 60, 12, 12, 1314007200, 60, 12, 12, 1314007260, 60, 12, 12, 1314007320, 60, 12, 12, 1314007380, 60, 12, 12, 1314007440, 60, 12, 12, 1314007500, 60, 12, 12, 1314007560, 60, 12, 12, 1314007620, 60, 12, 12, 1314007680, 60, 12, 12, 1314007740, 60, 12, 12, 1314007800, 60, 12, 12, 1314007860, 60, 12, 12, 1314007920, 60, 12, 12, 1314007980, 60, 12, 12, 1314008040, 60, 12, 12, 1314008100, 60, 12, 12, 1314008160, 60, 12, 12, 1314008220, 60, 12, 12, 1314008280, 60, 12, 12, 1314008340, 60, 12, 12, 1314008400, 60, 12, 12, 1314008460, 60, 12, 12, 1314008520, 60, 12, 12, 1314008580, 60, 12, 12, 1314008640, 60, 12, 12, 1314008700, 60, 12, 12, 1314008760, 60, 12, 12, 1314008820, 60, 12, 12, 1314008880, 60, 12, 12, 1314008940, 60, 12, 12, 1314009000, 60, 12, 12, 1314009060, 60, 12, 12, 1314009120, 60, 12, 12, 1314009180, 60, 12, 12, 1314009240, 60, 12, 12, 1314009300, 60, 12, 12, 1314009360, 60, 12, 12, 1314009420, 60, 12, 12, 1314009480, 60, 12, 12, 1314009540, 60, 12, 12, 1314009600, 60, 12, 12, 1314009660, 60, 12, 12, 1314009720, 60, 12, 12, 1314009780, 60, 12, 12, 1314009840, 60, 12, 12, 1314009900, 60, 12, 12, 1314009960, 60, 12, 12, 1314010020, 60, 12, 12, 1314010080, 60, 12, 12, 1314010140, 60, 12, 12, 1314010200, 60, 12, 12, 1314010260, 60, 12, 12, 1314010320, 60, 12, 12, 1314010380, 60, 12, 12, 1314010440, 60, 12, 12, 1314010500, 60, 12, 12, 1314010560, 60, 11, 11, 1314010620, 60, 12, 12, 1314010680, 60, 12, 12, 1314010740, 60, 12, 12, 1314010800, 60, 12, 12, 1314010860, 60, 12, 12, 1314010920, 60, 12, 12, 1314010980, 60, 12, 12, 1314011040, 60, 12, 12, 1314011100, 60, 12, 12, 1314011160, 60, 12, 12, 1314011220, 60, 12, 12, 1314011280, 60, 12, 12, 1314011340, 60, 12, 12, 1314011400, 60, 12, 12, 1314011460, 60, 12, 12, 1314011520, 60, 12, 12, 1314011580, 60, 12, 12, 1314011640, 60, 12, 12, 1314011700, 60, 12, 12, 1314011760, 60, 12, 12, 1314011820, 60, 12, 12, 1314011880, 60, 12, 12, 1314011940, 60, 12, 12, 1314012000, 60, 12, 12, 1314012060, 60, 12, 12, 1314012120, 60, 12, 12, 1314012180, 60, 12, 12, 1314012240, 60, 12, 12, 1314012300, 60, 12, 12, 1314012360, 60, 12, 12, 1314012420, 60, 12, 12, 1314012480, 60, 12, 12, 1314012540, 60, 12, 12, 1314012600, 60, 12, 12, 1314012660, 60, 12, 12, 1314012720, 60, 12, 12, 1314012780, 60, 12, 12, 1314012840, 60, 12, 12, 1314012900, 60, 12, 12, 1314012960, 60, 12, 12, 1314013020, 60, 12, 12, 1314013080, 60, 12, 12, 1314013140, 60, 12, 12, 1314013200, 60, 12, 12, 1314013260, 60, 12, 12, 1314013320, 60, 12, 12, 1314013380, 60, 12, 12, 1314013440, 60, 12, 12, 1314013500, 60, 12, 12, 1314013560, 60, 12, 12, 1314013620, 60, 12, 12, 1314013680, 60, 12, 12, 1314013740, 60, 12, 12, 1314013800, 60, 12, 12, 1314013860, 60, 12, 12, 1314013920, 60, 12, 12, 1314013980, 60, 12, 12, 1314014040, 60, 12, 12, 1314014100, 60, 12, 12, 1314014160, 60, 12, 12, 1314014220, 60, 12, 12, 1314014280, 60, 12, 12, 1314014340, 60, 12, 12, 1314014400, 60, 12, 12, 1314014460, 60, 12, 12, 1314014520, 60, 12, 12, 1314014580, 60, 12, 12, 1314014640, 60, 12, 12, 1314014700, 60, 12, 12, 1314014760, 60, 12, 12, 1314014820, 60, 12, 12, 1314014880, 60, 12, 12, 1314014940, 60, 12, 12, 1314015000, 60, 12, 12, 1314015060, 60, 12, 12, 1314015120, 60, 12, 12, 1314015180, 60, 12, 12, 1314015240, 60, 12, 12, 1314015300, 60, 12, 12, 1314015360, 60, 12, 12, 1314015420, 60, 12, 12, 1314015480, 60, 12, 12, 1314015540, 60, 12, 12, 1314015600, 60, 11, 11, 1314015660, 60, 12, 12, 1314015720, 60, 12, 12, 1314015780, 60, 12, 12, 1314015840, 60, 12, 12, 1314015900, 60, 12, 12, 1314015960, 60, 12, 12, 1314016020, 60, 12, 12, 1314016080, 60, 12, 12, 1314016140, 60, 12, 12, 1314016200, 60, 12, 12, 1314016260, 60, 12, 12, 1314016320, 60, 12, 12, 1314016380, 60, 12, 12, 1314016440, 60, 12, 12, 1314016500, 60, 12, 12, 1314016560, 60, 12, 12, 1314016620, 60, 12, 12, 1314016680, 60, 12, 12, 1314016740, 60, 12, 12, 1314016800, 60, 12, 12, 1314016860, 60, 12, 12, 1314016920, 60, 12, 12, 1314016980, 60, 12, 12, 1314017040, 60, 12, 12, 1314017100, 60, 12, 12, 1314017160, 60, 12, 12, 1314017220, 60, 12, 12, 1314017280, 60, 12, 12, 1314017340, 60, 12, 12, 1314017400, 60, 12, 12, 1314017460, 60, 12, 12, 1314017520, 60, 12, 12, 1314017580, 60, 12, 12, 1314017640, 60, 12, 12, 1314017700, 60, 12, 12, 1314017760, 60, 12, 12, 1314017820, 60, 12, 12, 1314017880, 60, 12, 12, 1314017940, 60, 12, 12, 1314018000, 60, 12, 12, 1314018060, 60, 12, 12, 1314018120, 60, 12, 12, 1314018180, 60, 12, 12, 1314018240, 60, 12, 12, 1314018300, 60, 12, 12, 1314018360, 60, 12, 12, 1314018420, 60, 12, 12, 1314018480, 60, 12, 12, 1314018540, 60, 12, 12, 1314018600, 60, 12, 12, 1314018660, 60, 12, 12, 1314018720, 60, 12, 12, 1314018780, 60, 12, 12, 1314018840, 60, 12, 12, 1314018900, 60, 12, 12, 1314018960, 60, 12, 12, 1314019020, 60, 12, 12, 1314019080, 60, 12, 12, 1314019140, 60, 12, 12, 1314019200, 60, 12, 12, 1314019260, 60, 12, 12, 1314019320, 60, 12, 12, 1314019380, 60, 12, 12, 1314019440, 60, 12, 12, 1314019500, 60, 12, 12, 1314019560, 60, 12, 12, 1314019620, 60, 12, 12, 1314019680, 60, 12, 12, 1314019740, 60, 12, 12, 1314019800, 60, 12, 12, 1314019860, 60, 12, 12, 1314019920, 60, 12, 12, 1314019980, 60, 12, 12, 1314020040, 60, 12, 12, 1314020100, 60, 12, 12, 1314020160, 60, 12, 12, 1314020220, 60, 12, 12, 1314020280, 60, 12, 12, 1314020340, 60, 12, 12, 1314020400, 60, 12, 12, 1314020460, 60, 12, 12, 1314020520, 60, 12, 12, 1314020580, 60, 12, 12, 1314020640, 60, 11, 11, 1314020700, 60, 12, 12, 1314020760, 60, 12, 12, 1314020820, 60, 12, 12, 1314020880, 60, 12, 12, 1314020940, 60, 12, 12, 1314021000, 60, 12, 12, 1314021060, 60, 12, 12, 1314021120, 60, 12, 12, 1314021180, 60, 12, 12, 1314021240, 60, 12, 12, 1314021300, 60, 12, 12, 1314021360, 60, 12, 12, 1314021420, 60, 12, 12, 1314021480, 60, 12, 12, 1314021540, 60, 12, 12, 1314021600, 60, 12, 12, 1314021660, 60, 12, 12, 1314021720, 60, 12, 12, 1314021780, 60, 12, 12, 1314021840, 60, 12, 12, 1314021900, 60, 12, 12, 1314021960, 60, 12, 12, 1314022020, 60, 12, 12, 1314022080, 60, 12, 12, 1314022140, 60, 12, 12, 1314022200, 60, 12, 12, 1314022260, 60, 12, 12, 1314022320, 60, 12, 12, 1314022380, 60, 12, 12, 1314022440, 60, 12, 12, 1314022500, 60, 12, 12, 1314022560, 60, 12, 12, 1314022620, 60, 12, 12, 1314022680, 60, 12, 12, 1314022740, 60, 12, 12, 1314022800, 60, 12, 12, 1314022860, 60, 12, 12, 1314022920, 60, 12, 12, 1314022980, 60, 12, 12, 1314023040, 60, 12, 12, 1314023100, 60, 12, 12, 1314023160, 60, 12, 12, 1314023220, 60, 12, 12, 1314023280, 60, 12, 12, 1314023340, 60, 12, 12, 1314023400, 60, 12, 12, 1314023460, 60, 12, 12, 1314023520, 60, 12, 12, 1314023580, 60, 12, 12, 1314023640, 60, 12, 12, 1314023700, 60, 12, 12, 1314023760, 60, 12, 12, 1314023820, 60, 12, 12, 1314023880, 60, 12, 12, 1314023940, 60, 12, 12, 1314024000, 60, 12, 12, 1314024060, 60, 12, 12, 1314024120, 60, 12, 12, 1314024180, 60, 12, 12, 1314024240, 60, 12, 12, 1314024300, 60, 12, 12, 1314024360, 60, 12, 12, 1314024420, 60, 12, 12, 1314024480, 60, 12, 12, 1314024540, 60, 12, 12, 1314024600, 60, 12, 12, 1314024660, 60, 12, 12, 1314024720, 60, 12, 12, 1314024780, 60, 12, 12, 1314024840, 60, 12, 12, 1314024900, 60, 12, 12, 1314024960, 60, 12, 12, 1314025020, 60, 12, 12, 1314025080, 60, 12, 12, 1314025140, 60, 12, 12, 1314025200, 60, 12, 12, 1314025260, 60, 12, 12, 1314025320, 60, 12, 12, 1314025380, 60, 12, 12, 1314025440, 60, 12, 12, 1314025500, 60, 12, 12, 1314025560, 60, 12, 12, 1314025620, 60, 12, 12, 1314025680, 60, 11, 11, 1314025740, 60, 12, 12, 1314025800, 60, 12, 12, 1314025860, 60, 12, 12, 1314025920, 60, 12, 12, 1314025980, 60, 12, 12, 1314026040, 60, 12, 12, 1314026100, 60, 12, 12, 1314026160, 60, 12, 12, 1314026220, 60, 12, 12, 1314026280, 60, 12, 12, 1314026340, 60, 12, 12, 1314026400, 60, 12, 12, 1314026460, 60, 12, 12, 1314026520, 60, 12, 12, 1314026580, 60, 12, 12, 1314026640, 60, 12, 12, 1314026700, 60, 12, 12, 1314026760, 60, 12, 12, 1314026820, 60, 12, 12, 1314026880, 60, 12, 12, 1314026940, 60, 12, 12, 1314027000, 60, 12, 12, 1314027060, 60, 12, 12, 1314027120, 60, 12, 12, 1314027180, 60, 12, 12, 1314027240, 60, 12, 12, 1314027300, 60, 12, 12, 1314027360, 60, 12, 12, 1314027420, 60, 12, 12, 1314027480, 60, 12, 12, 1314027540, 60, 12, 12, 1314027600, 60, 12, 12, 1314027660, 60, 12, 12, 1314027720, 60, 12, 12, 1314027780, 60, 12, 12, 1314027840, 60, 12, 12, 1314027900, 60, 12, 12, 1314027960, 60, 12, 12, 1314028020, 60, 12, 12, 1314028080, 60, 12, 12, 1314028140, 60, 12, 12, 1314028200, 60, 12, 12, 1314028260, 60, 12, 12, 1314028320, 60, 12, 12, 1314028380, 60, 12, 12, 1314028440, 60, 12, 12, 1314028500, 60, 12, 12, 1314028560, 60, 12, 12, 1314028620, 60, 12, 12, 1314028680, 60, 12, 12, 1314028740, 60, 12, 12, 1314028800, 60, 12, 12, 1314028860, 60, 12, 12, 1314028920, 60, 12, 12, 1314028980, 60, 12, 12, 1314029040, 60, 12, 12, 1314029100, 60, 12, 12, 1314029160, 60, 12, 12, 1314029220, 60, 12, 12, 1314029280, 60, 12, 12, 1314029340, 60, 12, 12, 1314029400, 60, 12, 12, 1314029460, 60, 12, 12, 1314029520, 60, 12, 12, 1314029580, 60, 12, 12, 1314029640, 60, 12, 12, 1314029700, 60, 12, 12, 1314029760, 60, 12, 12, 1314029820, 60, 12, 12, 1314029880, 60, 12, 12, 1314029940, 60, 12, 12, 1314030000, 60, 12, 12, 1314030060, 60, 12, 12, 1314030120, 60, 12, 12, 1314030180, 60, 12, 12, 1314030240, 60, 12, 12, 1314030300, 60, 12, 12, 1314030360, 60, 12, 12, 1314030420, 60, 12, 12, 1314030480, 60, 12, 12, 1314030540, 60, 12, 12, 1314030600, 60, 12, 12, 1314030660, 60, 12, 12, 1314030720, 60, 12, 12, 1314030780, 60, 11, 11, 1314030840, 60, 12, 12, 1314030900, 60, 12, 12, 1314030960, 60, 12, 12, 1314031020, 60, 12, 12, 1314031080, 60, 12, 12, 1314031140, 60, 12, 12, 1314031200, 60, 12, 12, 1314031260, 60, 12, 12, 1314031320, 60, 12, 12, 1314031380, 60, 12, 12, 1314031440, 60, 12, 12, 1314031500, 60, 12, 12, 1314031560, 60, 12, 12, 1314031620, 60, 12, 12, 1314031680, 60, 12, 12, 1314031740, 60, 12, 12, 1314031800, 60, 12, 12, 1314031860, 60, 12, 12, 1314031920, 60, 12, 12, 1314031980, 60, 12, 12, 1314032040, 60, 12, 12, 1314032100, 60, 12, 12, 1314032160, 60, 12, 12, 1314032220, 60, 12, 12, 1314032280, 60, 12, 12, 1314032340, 60, 12, 12, 1314032400, 60, 12, 12, 1314032460, 60, 12, 12, 1314032520, 60, 12, 12, 1314032580, 60, 12, 12, 1314032640, 60, 12, 12, 1314032700, 60, 12, 12, 1314032760, 60, 12, 12, 1314032820, 60, 12, 12, 1314032880, 60, 12, 12, 1314032940, 60, 12, 12, 1314033000, 60, 12, 12, 1314033060, 60, 12, 12, 1314033120, 60, 12, 12, 1314033180, 60, 12, 12, 1314033240, 60, 12, 12, 1314033300, 60, 12, 12, 1314033360, 60, 12, 12, 1314033420, 60, 12, 12, 1314033480, 60, 12, 12, 1314033540, 60, 12, 12, 1314033600, 60, 12, 12, 1314033660, 60, 12, 12, 1314033720, 60, 12, 12, 1314033780, 60, 12, 12, 1314033840, 60, 12, 12, 1314033900, 60, 12, 12, 1314033960, 60, 12, 12, 1314034020, 60, 12, 12, 1314034080, 60, 12, 12, 1314034140, 60, 12, 12, 1314034200, 60, 12, 12, 1314034260, 60, 12, 12, 1314034320, 60, 12, 12, 1314034380, 60, 12, 12, 1314034440, 60, 12, 12, 1314034500, 60, 12, 12, 1314034560, 60, 12, 12, 1314034620, 60, 12, 12, 1314034680, 60, 12, 12, 1314034740, 60, 12, 12, 1314034800, 60, 12, 12, 1314034860, 60, 12, 12, 1314034920, 60, 12, 12, 1314034980, 60, 12, 12, 1314035040, 60, 12, 12, 1314035100, 60, 12, 12, 1314035160, 60, 12, 12, 1314035220, 60, 12, 12, 1314035280, 60, 12, 12, 1314035340, 60, 12, 12, 1314035400, 60, 11, 11, 1314035460, 60, 12, 12, 1314035520, 60, 12, 12, 1314035580, 60, 12, 12, 1314035640, 60, 12, 12, 1314035700, 60, 12, 12, 1314035760, 60, 12, 12, 1314035820, 60, 12, 12, 1314035880, 60, 12, 12, 1314035940, 60, 12, 12, 1314036000, 60, 12, 12, 1314036060, 60, 12, 12, 1314036120, 60, 12, 12, 1314036180, 60, 12, 12, 1314036240, 60, 12, 12, 1314036300, 60, 12, 12, 1314036360, 60, 12, 12, 1314036420, 60, 12, 12, 1314036480, 60, 12, 12, 1314036540, 60, 12, 12, 1314036600, 60, 12, 12, 1314036660, 60, 12, 12, 1314036720, 60, 12, 12, 1314036780, 60, 12, 12, 1314036840, 60, 12, 12, 1314036900, 60, 12, 12, 1314036960, 60, 12, 12, 1314037020, 60, 12, 12, 1314037080, 60, 12, 12, 1314037140, 60, 12, 12, 1314037200, 60, 12, 12, 1314037260, 60, 12, 12, 1314037320, 60, 12, 12, 1314037380, 60, 12, 12, 1314037440, 60, 12, 12, 1314037500, 60, 12, 12, 1314037560, 60, 12, 12, 1314037620, 60, 12, 12, 1314037680, 60, 12, 12, 1314037740, 60, 12, 12, 1314037800, 60, 12, 12, 1314037860, 60, 12, 12, 1314037920, 60, 12, 12, 1314037980, 60, 12, 12, 1314038040, 60, 12, 12, 1314038100, 60, 12, 12, 1314038160, 60, 12, 12, 1314038220, 60, 12, 12, 1314038280, 60, 12, 12, 1314038340, 60, 12, 12, 1314038400, 60, 12, 12, 1314038460, 60, 12, 12, 1314038520, 60, 12, 12, 1314038580, 60, 12, 12, 1314038640, 60, 12, 12, 1314038700, 60, 12, 12, 1314038760, 60, 12, 12, 1314038820, 60, 12, 12, 1314038880, 60, 12, 12, 1314038940, 60, 12, 12, 1314039000, 60, 12, 12, 1314039060, 60, 12, 12, 1314039120, 60, 12, 12, 1314039180, 60, 12, 12, 1314039240, 60, 12, 12, 1314039300, 60, 12, 12, 1314039360, 60, 12, 12, 1314039420, 60, 12, 12, 1314039480, 60, 12, 12, 1314039540, 60, 12, 12, 1314039600, 60, 12, 12, 1314039660, 60, 12, 12, 1314039720, 60, 12, 12, 1314039780, 60, 12, 12, 1314039840, 60, 12, 12, 1314039900, 60, 12, 12, 1314039960, 60, 12, 12, 1314040020, 60, 12, 12, 1314040080, 60, 12, 12, 1314040140, 60, 12, 12, 1314040200, 60, 12, 12, 1314040260, 60, 12, 12, 1314040320, 60, 12, 12, 1314040380, 60, 11, 11, 1314040440, 60, 12, 12, 1314040500, 60, 12, 12, 1314040560, 60, 12, 12, 1314040620, 60, 12, 12, 1314040680, 60, 12, 12, 1314040740, 60, 12, 12, 1314040800, 60, 12, 12, 1314040860, 60, 12, 12, 1314040920, 60, 12, 12, 1314040980, 60, 12, 12, 1314041040, 60, 12, 12, 1314041100, 60, 12, 12, 1314041160, 60, 12, 12, 1314041220, 60, 12, 12, 1314041280, 60, 12, 12, 1314041340, 60, 12, 12, 1314041400, 60, 12, 12, 1314041460, 60, 12, 12, 1314041520, 60, 12, 12, 1314041580, 60, 12, 12, 1314041640, 60, 12, 12, 1314041700, 60, 12, 12, 1314041760, 60, 12, 12, 1314041820, 60, 12, 12, 1314041880, 60, 12, 12, 1314041940, 60, 12, 12, 1314042000, 60, 12, 12, 1314042060, 60, 12, 12, 1314042120, 60, 12, 12, 1314042180, 60, 12, 12, 1314042240, 60, 12, 12, 1314042300, 60, 12, 12, 1314042360, 60, 12, 12, 1314042420, 60, 12, 12, 1314042480, 60, 12, 12, 1314042540, 60, 12, 12, 1314042600, 60, 12, 12, 1314042660, 60, 12, 12, 1314042720, 60, 12, 12, 1314042780, 60, 12, 12, 1314042840, 60, 12, 12, 1314042900, 60, 12, 12, 1314042960, 60, 12, 12, 1314043020, 60, 12, 12, 1314043080, 60, 12, 12, 1314043140, 60, 12, 12, 1314043200, 60, 12, 12, 1314043260, 60, 12, 12, 1314043320, 60, 12, 12, 1314043380, 60, 12, 12, 1314043440, 60, 12, 12, 1314043500, 60, 12, 12, 1314043560, 60, 12, 12, 1314043620, 60, 12, 12, 1314043680, 60, 12, 12, 1314043740, 60, 12, 12, 1314043800, 60, 12, 12, 1314043860, 60, 12, 12, 1314043920, 60, 12, 12, 1314043980, 60, 12, 12, 1314044040, 60, 12, 12, 1314044100, 60, 12, 12, 1314044160, 60, 12, 12, 1314044220, 60, 12, 12, 1314044280, 60, 12, 12, 1314044340, 60, 12, 12, 1314044400, 60, 12, 12, 1314044460, 60, 12, 12, 1314044520, 60, 12, 12, 1314044580, 60, 12, 12, 1314044640, 60, 12, 12, 1314044700, 60, 12, 12, 1314044760, 60, 12, 12, 1314044820, 60, 12, 12, 1314044880, 60, 12, 12, 1314044940, 60, 12, 12, 1314045000, 60, 12, 12, 1314045060, 60, 12, 12, 1314045120, 60, 12, 12, 1314045180, 60, 12, 12, 1314045240, 60, 12, 12, 1314045300, 60, 12, 12, 1314045360, 60, 11, 11, 1314045420, 60, 12, 12, 1314045480, 60, 12, 12, 1314045540, 60, 12, 12, 1314045600, 60, 12, 12, 1314045660, 60, 12, 12, 1314045720, 60, 12, 12, 1314045780, 60, 12, 12, 1314045840, 60, 12, 12, 1314045900, 60, 12, 12, 1314045960, 60, 12, 12, 1314046020, 60, 12, 12, 1314046080, 60, 12, 12, 1314046140, 60, 12, 12, 1314046200, 60, 12, 12, 1314046260, 60, 12, 12, 1314046320, 60, 12, 12, 1314046380, 60, 12, 12, 1314046440, 60, 12, 12, 1314046500, 60, 12, 12, 1314046560, 60, 12, 12, 1314046620, 60, 12, 12, 1314046680, 60, 12, 12, 1314046740, 60, 12, 12, 1314046800, 60, 12, 12, 1314046860, 60, 12, 12, 1314046920, 60, 12, 12, 1314046980, 60, 12, 12, 1314047040, 60, 12, 12, 1314047100, 60, 12, 12, 1314047160, 60, 12, 12, 1314047220, 60, 12, 12, 1314047280, 60, 12, 12, 1314047340, 60, 12, 12, 1314047400, 60, 12, 12, 1314047460, 60, 12, 12, 1314047520, 60, 12, 12, 1314047580, 60, 12, 12, 1314047640, 60, 12, 12, 1314047700, 60, 12, 12, 1314047760, 60, 12, 12, 1314047820, 60, 12, 12, 1314047880, 60, 12, 12, 1314047940, 60, 12, 12, 1314048000, 60, 12, 12, 1314048060, 60, 12, 12, 1314048120, 60, 12, 12, 1314048180, 60, 12, 12, 1314048240, 60, 12, 12, 1314048300, 60, 12, 12, 1314048360, 60, 12, 12, 1314048420, 60, 12, 12, 1314048480, 60, 12, 12, 1314048540, 60, 12, 12, 1314048600, 60, 12, 12, 1314048660, 60, 12, 12, 1314048720, 60, 12, 12, 1314048780, 60, 12, 12, 1314048840, 60, 12, 12, 1314048900, 60, 12, 12, 1314048960, 60, 12, 12, 1314049020, 60, 12, 12, 1314049080, 60, 12, 12, 1314049140, 60, 12, 12, 1314049200, 60, 12, 12, 1314049260, 60, 12, 12, 1314049320, 60, 12, 12, 1314049380, 60, 12, 12, 1314049440, 60, 12, 12, 1314049500, 60, 12, 12, 1314049560, 60, 12, 12, 1314049620, 60, 12, 12, 1314049680, 60, 12, 12, 1314049740, 60, 11, 11, 1314049800, 60, 12, 12, 1314049860, 60, 12, 12, 1314049920, 60, 12, 12, 1314049980, 60, 12, 12, 1314050040, 60, 12, 12, 1314050100, 60, 12, 12, 1314050160, 60, 12, 12, 1314050220, 60, 12, 12, 1314050280, 60, 12, 12, 1314050340, 60, 12, 12, 1314050400, 60, 12, 12, 1314050460, 60, 12, 12, 1314050520, 60, 12, 12, 1314050580, 60, 12, 12, 1314050640, 60, 12, 12, 1314050700, 60, 12, 12, 1314050760, 60, 12, 12, 1314050820, 60, 12, 12, 1314050880, 60, 12, 12, 1314050940, 60, 12, 12, 1314051000, 60, 12, 12, 1314051060, 60, 12, 12, 1314051120, 60, 12, 12, 1314051180, 60, 12, 12, 1314051240, 60, 12, 12, 1314051300, 60, 12, 12, 1314051360, 60, 12, 12, 1314051420, 60, 12, 12, 1314051480, 60, 12, 12, 1314051540, 60, 12, 12, 1314051600, 60, 12, 12, 1314051660, 60, 12, 12, 1314051720, 60, 12, 12, 1314051780, 60, 12, 12, 1314051840, 60, 12, 12, 1314051900, 60, 12, 12, 1314051960, 60, 12, 12, 1314052020, 60, 12, 12, 1314052080, 60, 12, 12, 1314052140, 60, 12, 12, 1314052200, 60, 12, 12, 1314052260, 60, 12, 12, 1314052320, 60, 12, 12, 1314052380, 60, 12, 12, 1314052440, 60, 12, 12, 1314052500, 60, 12, 12, 1314052560, 60, 12, 12, 1314052620, 60, 12, 12, 1314052680, 60, 12, 12, 1314052740, 60, 12, 12, 1314052800, 60, 12, 12, 1314052860, 60, 12, 12, 1314052920, 60, 12, 12, 1314052980, 60, 12, 12, 1314053040, 60, 12, 12, 1314053100, 60, 12, 12, 1314053160, 60, 12, 12, 1314053220, 60, 12, 12, 1314053280, 60, 12, 12, 1314053340, 60, 12, 12, 1314053400, 60, 12, 12, 1314053460, 60, 12, 12, 1314053520, 60, 12, 12, 1314053580, 60, 12, 12, 1314053640, 60, 12, 12, 1314053700, 60, 12, 12, 1314053760, 60, 12, 12, 1314053820, 60, 17, 17, 1314053880, 60, 12, 12, 1314053940, 60, 12, 12, 1314054000, 60, 12, 12, 1314054060, 60, 12, 12, 1314054120, 60, 7, 7, 1314054180, 60, 6, 6, 1314054240, 60, 12, 12, 1314054300, 60, 17, 17, 1314054360, 60, 12, 12, 1314054420, 60, 12, 12, 1314054480, 60, 12, 12, 1314054540, 60, 22, 22, 1314054600, 60, 17, 17, 1314054660, 60, 12, 12, 1314054720, 60, 12, 12, 1314054780, 60, 17, 17, 1314054840, 60, 22, 22, 1314054900, 60, 12, 12, 1314054960, 60, 12, 12, 1314055020, 60, 12, 12, 1314055080, 60, 12, 12, 1314055140, 60, 10, 10, 1314055320, 60, 11, 11, 1314055380, 60, 12, 12, 1314055440, 60, 12, 12, 1314055500, 60, 12, 12, 1314055560, 60, 12, 12, 1314055620, 60, 12, 12, 1314055680, 60, 12, 12, 1314055740, 60, 12, 12, 1314055800, 60, 12, 12, 1314055860, 60, 12, 12, 1314055920, 60, 12, 12, 1314055980, 60, 12, 12, 1314056040, 60, 12, 12, 1314056100, 60, 12, 12, 1314056160, 60, 12, 12, 1314056220, 60, 12, 12, 1314056280, 60, 12, 12, 1314056340, 60, 12, 12, 1314056400, 60, 12, 12, 1314056460, 60, 12, 12, 1314056520, 60, 12, 12, 1314056580, 60, 12, 12, 1314056640, 60, 11, 11, 1314056700, 60, 12, 12, 1314056760, 60, 12, 12, 1314056820, 60, 12, 12, 1314056880, 60, 12, 12, 1314056940, 60, 12, 12, 1314057000, 60, 12, 12, 1314057060, 60, 12, 12, 1314057120, 60, 12, 12, 1314057180, 60, 12, 12, 1314057240, 60, 12, 12, 1314057300, 60, 12, 12, 1314057360, 60, 12, 12, 1314057420, 60, 12, 12, 1314057480, 60, 12, 12, 1314057540, 60, 12, 12, 1314057600, 60, 12, 12, 1314057660, 60, 12, 12, 1314057720, 60, 12, 12, 1314057780, 60, 12, 12, 1314057840, 60, 12, 12, 1314057900, 60, 12, 12, 1314057960, 60, 12, 12, 1314058020, 60, 12, 12, 1314058080, 60, 12, 12, 1314058140, 60, 12, 12, 1314058200, 60, 12, 12, 1314058260, 60, 12, 12, 1314058320, 60, 12, 12, 1314058380, 60, 12, 12, 1314058440, 60, 12, 12, 1314058500, 60, 12, 12, 1314058560, 60, 12, 12, 1314058620, 60, 12, 12, 1314058680, 60, 12, 12, 1314058740, 60, 12, 12, 1314058800, 60, 12, 12, 1314058860, 60, 12, 12, 1314058920, 60, 12, 12, 1314058980, 60, 12, 12, 1314059040, 60, 12, 12, 1314059100, 60, 12, 12, 1314059160, 60, 12, 12, 1314059220, 60, 12, 12, 1314059280, 60, 12, 12, 1314059340, 60, 12, 12, 1314059400, 60, 12, 12, 1314059460, 60, 12, 12, 1314059520, 60, 12, 12, 1314059580, 60, 12, 12, 1314059640, 60, 12, 12, 1314059700, 60, 12, 12, 1314059760, 60, 12, 12, 1314059820, 60, 12, 12, 1314059880, 60, 12, 12, 1314059940, 60, 12, 12, 1314060000, 60, 12, 12, 1314060060, 60, 12, 12, 1314060120, 60, 12, 12, 1314060180, 60, 12, 12, 1314060240, 60, 12, 12, 1314060300, 60, 12, 12, 1314060360, 60, 12, 12, 1314060420, 60, 12, 12, 1314060480, 60, 12, 12, 1314060540, 60, 12, 12, 1314060600, 60, 12, 12, 1314060660, 60, 12, 12, 1314060720, 60, 12, 12, 1314060780, 60, 12, 12, 1314060840, 60, 12, 12, 1314060900, 60, 12, 12, 1314060960, 60, 12, 12, 1314061020, 60, 12, 12, 1314061080, 60, 12, 12, 1314061140, 60, 12, 12, 1314061200, 60, 12, 12, 1314061260, 60, 12, 12, 1314061320, 60, 12, 12, 1314061380, 60, 12, 12, 1314061440, 60, 12, 12, 1314061500, 60, 12, 12, 1314061560, 60, 11, 11, 1314061620, 60, 12, 12, 1314061680, 60, 12, 12, 1314061740, 60, 12, 12, 1314061800, 60, 12, 12, 1314061860, 60, 12, 12, 1314061920, 60, 12, 12, 1314061980, 60, 12, 12, 1314062040, 60, 12, 12, 1314062100, 60, 12, 12, 1314062160, 60, 12, 12, 1314062220, 60, 12, 12, 1314062280, 60, 12, 12, 1314062340, 60, 12, 12, 1314062400, 60, 12, 12, 1314062460, 60, 12, 12, 1314062520, 60, 12, 12, 1314062580, 60, 12, 12, 1314062640, 60, 12, 12, 1314062700, 60, 12, 12, 1314062760, 60, 12, 12, 1314062820, 60, 12, 12, 1314062880, 60, 12, 12, 1314062940, 60, 12, 12, 1314063000, 60, 12, 12, 1314063060, 60, 12, 12, 1314063120, 60, 12, 12, 1314063180, 60, 12, 12, 1314063240, 60, 12, 12, 1314063300, 60, 12, 12, 1314063360, 60, 12, 12, 1314063420, 60, 12, 12, 1314063480, 60, 12, 12, 1314063540, 60, 12, 12, 1314063600, 60, 12, 12, 1314063660, 60, 12, 12, 1314063720, 60, 12, 12, 1314063780, 60, 12, 12, 1314063840, 60, 12, 12, 1314063900, 60, 12, 12, 1314063960, 60, 12, 12, 1314064020, 60, 12, 12, 1314064080, 60, 12, 12, 1314064140, 60, 12, 12, 1314064200, 60, 12, 12, 1314064260, 60, 12, 12, 1314064320, 60, 12, 12, 1314064380, 60, 12, 12, 1314064440, 60, 12, 12, 1314064500, 60, 12, 12, 1314064560, 60, 12, 12, 1314064620, 60, 12, 12, 1314064680, 60, 12, 12, 1314064740, 60, 12, 12, 1314064800, 60, 12, 12, 1314064860, 60, 12, 12, 1314064920, 60, 12, 12, 1314064980, 60, 12, 12, 1314065040, 60, 12, 12, 1314065100, 60, 12, 12, 1314065160, 60, 12, 12, 1314065220, 60, 12, 12, 1314065280, 60, 12, 12, 1314065340, 60, 12, 12, 1314065400, 60, 12, 12, 1314065460, 60, 12, 12, 1314065520, 60, 12, 12, 1314065580, 60, 12, 12, 1314065640, 60, 12, 12, 1314065700, 60, 12, 12, 1314065760, 60, 12, 12, 1314065820, 60, 12, 12, 1314065880, 60, 12, 12, 1314065940, 60, 12, 12, 1314066000, 60, 12, 12, 1314066060, 60, 12, 12, 1314066120, 60, 12, 12, 1314066180, 60, 12, 12, 1314066240, 60, 12, 12, 1314066300, 60, 12, 12, 1314066360, 60, 12, 12, 1314066420, 60, 12, 12, 1314066480, 60, 12, 12, 1314066540, 60, 11, 11, 1314066600, 60, 12, 12, 1314066660, 60, 12, 12, 1314066720, 60, 12, 12, 1314066780, 60, 12, 12, 1314066840, 60, 12, 12, 1314066900, 60, 12, 12, 1314066960, 60, 12, 12, 1314067020, 60, 12, 12, 1314067080, 60, 12, 12, 1314067140, 60, 12, 12, 1314067200, 60, 12, 12, 1314067260, 60, 12, 12, 1314067320, 60, 12, 12, 1314067380, 60, 12, 12, 1314067440, 60, 12, 12, 1314067500, 60, 12, 12, 1314067560, 60, 12, 12, 1314067620, 60, 12, 12, 1314067680, 60, 12, 12, 1314067740, 60, 12, 12, 1314067800, 60, 12, 12, 1314067860, 60, 12, 12, 1314067920, 60, 12, 12, 1314067980, 60, 12, 12, 1314068040, 60, 12, 12, 1314068100, 60, 12, 12, 1314068160, 60, 12, 12, 1314068220, 60, 12, 12, 1314068280, 60, 12, 12, 1314068340, 60, 12, 12, 1314068400, 60, 12, 12, 1314068460, 60, 12, 12, 1314068520, 60, 12, 12, 1314068580, 60, 12, 12, 1314068640, 60, 12, 12, 1314068700, 60, 12, 12, 1314068760, 60, 12, 12, 1314068820, 60, 12, 12, 1314068880, 60, 12, 12, 1314068940, 60, 12, 12, 1314069000, 60, 12, 12, 1314069060, 60, 12, 12, 1314069120, 60, 12, 12, 1314069180, 60, 12, 12, 1314069240, 60, 12, 12, 1314069300, 60, 12, 12, 1314069360, 60, 12, 12, 1314069420, 60, 12, 12, 1314069480, 60, 12, 12, 1314069540, 60, 12, 12, 1314069600, 60, 12, 12, 1314069660, 60, 12, 12, 1314069720, 60, 12, 12, 1314069780, 60, 12, 12, 1314069840, 60, 12, 12, 1314069900, 60, 12, 12, 1314069960, 60, 12, 12, 1314070020, 60, 12, 12, 1314070080, 60, 12, 12, 1314070140, 60, 12, 12, 1314070200, 60, 12, 12, 1314070260, 60, 12, 12, 1314070320, 60, 12, 12, 1314070380, 60, 12, 12, 1314070440, 60, 12, 12, 1314070500, 60, 12, 12, 1314070560, 60, 12, 12, 1314070620, 60, 12, 12, 1314070680, 60, 12, 12, 1314070740, 60, 12, 12, 1314070800, 60, 12, 12, 1314070860, 60, 12, 12, 1314070920, 60, 12, 12, 1314070980, 60, 12, 12, 1314071040, 60, 12, 12, 1314071100, 60, 12, 12, 1314071160, 60, 12, 12, 1314071220, 60, 12, 12, 1314071280, 60, 12, 12, 1314071340, 60, 12, 12, 1314071400, 60, 12, 12, 1314071460, 60, 12, 12, 1314071520, 60, 11, 11, 1314071580, 60, 12, 12, 1314071640, 60, 12, 12, 1314071700, 60, 12, 12, 1314071760, 60, 12, 12, 1314071820, 60, 12, 12, 1314071880, 60, 12, 12, 1314071940, 60, 12, 12, 1314072000, 60, 12, 12, 1314072060, 60, 12, 12, 1314072120, 60, 12, 12, 1314072180, 60, 12, 12, 1314072240, 60, 12, 12, 1314072300, 60, 12, 12, 1314072360, 60, 12, 12, 1314072420, 60, 12, 12, 1314072480, 60, 12, 12, 1314072540, 60, 12, 12, 1314072600, 60, 12, 12, 1314072660, 60, 12, 12, 1314072720, 60, 12, 12, 1314072780, 60, 12, 12, 1314072840, 60, 12, 12, 1314072900, 60, 12, 12, 1314072960, 60, 12, 12, 1314073020, 60, 12, 12, 1314073080, 60, 12, 12, 1314073140, 60, 12, 12, 1314073200, 60, 12, 12, 1314073260, 60, 12, 12, 1314073320, 60, 12, 12, 1314073380, 60, 12, 12, 1314073440, 60, 12, 12, 1314073500, 60, 12, 12, 1314073560, 60, 12, 12, 1314073620, 60, 12, 12, 1314073680, 60, 12, 12, 1314073740, 60, 12, 12, 1314073800, 60, 12, 12, 1314073860, 60, 12, 12, 1314073920, 60, 12, 12, 1314073980, 60, 12, 12, 1314074040, 60, 12, 12, 1314074100, 60, 12, 12, 1314074160, 60, 12, 12, 1314074220, 60, 12, 12, 1314074280, 60, 12, 12, 1314074340, 60, 12, 12, 1314074400, 60, 12, 12, 1314074460, 60, 12, 12, 1314074520, 60, 12, 12, 1314074580, 60, 12, 12, 1314074640, 60, 12, 12, 1314074700, 60, 12, 12, 1314074760, 60, 12, 12, 1314074820, 60, 12, 12, 1314074880, 60, 12, 12, 1314074940, 60, 12, 12, 1314075000, 60, 12, 12, 1314075060, 60, 12, 12, 1314075120, 60, 12, 12, 1314075180, 60, 12, 12, 1314075240, 60, 12, 12, 1314075300, 60, 12, 12, 1314075360, 60, 12, 12, 1314075420, 60, 12, 12, 1314075480, 60, 12, 12, 1314075540, 60, 12, 12, 1314075600, 60, 12, 12, 1314075660, 60, 12, 12, 1314075720, 60, 12, 12, 1314075780, 60, 12, 12, 1314075840, 60, 12, 12, 1314075900, 60, 12, 12, 1314075960, 60, 12, 12, 1314076020, 60, 12, 12, 1314076080, 60, 12, 12, 1314076140, 60, 12, 12, 1314076200, 60, 12, 12, 1314076260, 60, 12, 12, 1314076320, 60, 12, 12, 1314076380, 60, 12, 12, 1314076440, 60, 12, 12, 1314076500, 60, 11, 11, 1314076560, 60, 12, 12, 1314076620, 60, 12, 12, 1314076680, 60, 12, 12, 1314076740, 60, 12, 12, 1314076800, 60, 12, 12, 1314076860, 60, 12, 12, 1314076920, 60, 12, 12, 1314076980, 60, 12, 12, 1314077040, 60, 12, 12, 1314077100, 60, 12, 12, 1314077160, 60, 12, 12, 1314077220, 60, 12, 12, 1314077280, 60, 12, 12, 1314077340, 60, 12, 12, 1314077400, 60, 12, 12, 1314077460, 60, 12, 12, 1314077520, 60, 12, 12, 1314077580, 60, 12, 12, 1314077640, 60, 12, 12, 1314077700, 60, 12, 12, 1314077760, 60, 12, 12, 1314077820, 60, 12, 12, 1314077880, 60, 12, 12, 1314077940, 60, 12, 12, 1314078000, 60, 12, 12, 1314078060, 60, 12, 12, 1314078120, 60, 12, 12, 1314078180, 60, 12, 12, 1314078240, 60, 12, 12, 1314078300, 60, 12, 12, 1314078360, 60, 12, 12, 1314078420, 60, 12, 12, 1314078480, 60, 12, 12, 1314078540, 60, 12, 12, 1314078600, 60, 12, 12, 1314078660, 60, 12, 12, 1314078720, 60, 12, 12, 1314078780, 60, 12, 12, 1314078840, 60, 12, 12, 1314078900, 60, 12, 12, 1314078960, 60, 12, 12, 1314079020, 60, 12, 12, 1314079080, 60, 12, 12, 1314079140, 60, 12, 12, 1314079200, 60, 12, 12, 1314079260, 60, 12, 12, 1314079320, 60, 12, 12, 1314079380, 60, 12, 12, 1314079440, 60, 12, 12, 1314079500, 60, 12, 12, 1314079560, 60, 12, 12, 1314079620, 60, 12, 12, 1314079680, 60, 12, 12, 1314079740, 60, 12, 12, 1314079800, 60, 12, 12, 1314079860, 60, 12, 12, 1314079920, 60, 12, 12, 1314079980, 60, 12, 12, 1314080040, 60, 12, 12, 1314080100, 60, 12, 12, 1314080160, 60, 12, 12, 1314080220, 60, 12, 12, 1314080280, 60, 12, 12, 1314080340, 60, 12, 12, 1314080400, 60, 12, 12, 1314080460, 60, 12, 12, 1314080520, 60, 12, 12, 1314080580, 60, 12, 12, 1314080640, 60, 12, 12, 1314080700, 60, 12, 12, 1314080760, 60, 12, 12, 1314080820, 60, 12, 12, 1314080880, 60, 12, 12, 1314080940, 60, 12, 12, 1314081000, 60, 12, 12, 1314081060, 60, 12, 12, 1314081120, 60, 12, 12, 1314081180, 60, 12, 12, 1314081240, 60, 12, 12, 1314081300, 60, 12, 12, 1314081360, 60, 12, 12, 1314081420, 60, 11, 11, 1314081480, 60, 12, 12, 1314081540, 60, 12, 12, 1314081600, 60, 12, 12, 1314081660, 60, 12, 12, 1314081720, 60, 12, 12, 1314081780, 60, 12, 12, 1314081840, 60, 12, 12, 1314081900, 60, 12, 12, 1314081960, 60, 12, 12, 1314082020, 60, 12, 12, 1314082080, 60, 12, 12, 1314082140, 60, 12, 12, 1314082200, 60, 12, 12, 1314082260, 60, 12, 12, 1314082320, 60, 12, 12, 1314082380, 60, 12, 12, 1314082440, 60, 12, 12, 1314082500, 60, 12, 12, 1314082560, 60, 12, 12, 1314082620, 60, 12, 12, 1314082680, 60, 12, 12, 1314082740, 60, 12, 12, 1314082800, 60, 12, 12, 1314082860, 60, 12, 12, 1314082920, 60, 12, 12, 1314082980, 60, 12, 12, 1314083040, 60, 12, 12, 1314083100, 60, 12, 12, 1314083160, 60, 12, 12, 1314083220, 60, 12, 12, 1314083280, 60, 12, 12, 1314083340, 60, 12, 12, 1314083400, 60, 12, 12, 1314083460, 60, 12, 12, 1314083520, 60, 12, 12, 1314083580, 60, 12, 12, 1314083640, 60, 12, 12, 1314083700, 60, 12, 12, 1314083760, 60, 12, 12, 1314083820, 60, 12, 12, 1314083880, 60, 12, 12, 1314083940, 60, 12, 12, 1314084000, 60, 12, 12, 1314084060, 60, 12, 12, 1314084120, 60, 12, 12, 1314084180, 60, 12, 12, 1314084240, 60, 12, 12, 1314084300, 60, 12, 12, 1314084360, 60, 12, 12, 1314084420, 60, 12, 12, 1314084480, 60, 12, 12, 1314084540, 60, 12, 12, 1314084600, 60, 12, 12, 1314084660, 60, 12, 12, 1314084720, 60, 12, 12, 1314084780, 60, 12, 12, 1314084840, 60, 12, 12, 1314084900, 60, 12, 12, 1314084960, 60, 12, 12, 1314085020, 60, 12, 12, 1314085080, 60, 12, 12, 1314085140, 60, 12, 12, 1314085200, 60, 12, 12, 1314085260, 60, 12, 12, 1314085320, 60, 12, 12, 1314085380, 60, 12, 12, 1314085440, 60, 12, 12, 1314085500, 60, 12, 12, 1314085560, 60, 12, 12, 1314085620, 60, 12, 12, 1314085680, 60, 12, 12, 1314085740, 60, 12, 12, 1314085800, 60, 12, 12, 1314085860, 60, 12, 12, 1314085920, 60, 12, 12, 1314085980, 60, 12, 12, 1314086040, 60, 12, 12, 1314086100, 60, 12, 12, 1314086160, 60, 12, 12, 1314086220, 60, 12, 12, 1314086280, 60, 12, 12, 1314086340, 60, 12, 12, 1314086400, 60, 11, 11, 1314086460, 60, 12, 12, 1314086520, 60, 12, 12, 1314086580, 60, 12, 12, 1314086640, 60, 12, 12, 1314086700, 60, 12, 12, 1314086760, 60, 12, 12, 1314086820, 60, 12, 12, 1314086880, 60, 12, 12, 1314086940, 60, 12, 12, 1314087000, 60, 12, 12, 1314087060, 60, 12, 12, 1314087120, 60, 12, 12, 1314087180, 60, 12, 12, 1314087240, 60, 12, 12, 1314087300, 60, 12, 12, 1314087360, 60, 12, 12, 1314087420, 60, 12, 12, 1314087480, 60, 12, 12, 1314087540, 60, 12, 12, 1314087600, 60, 12, 12, 1314087660, 60, 12, 12, 1314087720, 60, 12, 12, 1314087780, 60, 12, 12, 1314087840, 60, 12, 12, 1314087900, 60, 12, 12, 1314087960, 60, 12, 12, 1314088020, 60, 12, 12, 1314088080, 60, 12, 12, 1314088140, 60, 12, 12, 1314088200, 60, 12, 12, 1314088260, 60, 12, 12, 1314088320, 60, 12, 12, 1314088380, 60, 12, 12, 1314088440, 60, 12, 12, 1314088500, 60, 12, 12, 1314088560, 60, 12, 12, 1314088620, 60, 12, 12, 1314088680, 60, 12, 12, 1314088740, 60, 12, 12, 1314088800, 60, 12, 12, 1314088860, 60, 12, 12, 1314088920, 60, 12, 12, 1314088980, 60, 12, 12, 1314089040, 60, 12, 12, 1314089100, 60, 12, 12, 1314089160, 60, 12, 12, 1314089220, 60, 12, 12, 1314089280, 60, 12, 12, 1314089340, 60, 12, 12, 1314089400, 60, 12, 12, 1314089460, 60, 12, 12, 1314089520, 60, 12, 12, 1314089580, 60, 12, 12, 1314089640, 60, 12, 12, 1314089700, 60, 12, 12, 1314089760, 60, 12, 12, 1314089820, 60, 12, 12, 1314089880, 60, 12, 12, 1314089940, 60, 12, 12, 1314090000, 60, 12, 12, 1314090060, 60, 12, 12, 1314090120, 60, 12, 12, 1314090180, 60, 12, 12, 1314090240, 60, 12, 12, 1314090300, 60, 12, 12, 1314090360, 60, 12, 12, 1314090420, 60, 12, 12, 1314090480, 60, 12, 12, 1314090540, 60, 12, 12, 1314090600, 60, 12, 12, 1314090660, 60, 12, 12, 1314090720, 60, 12, 12, 1314090780, 60, 12, 12, 1314090840, 60, 12, 12, 1314090900, 60, 12, 12, 1314090960, 60, 12, 12, 1314091020, 60, 12, 12, 1314091080, 60, 12, 12, 1314091140, 60, 12, 12, 1314091200, 60, 12, 12, 1314091260, 60, 12, 12, 1314091320, 60, 12, 12, 1314091380, 60, 11, 11, 1314091440, 60, 12, 12, 1314091500, 60, 12, 12, 1314091560, 60, 12, 12, 1314091620, 60, 12, 12, 1314091680, 60, 12, 12, 1314091740, 60, 12, 12, 1314091800, 60, 12, 12, 1314091860, 60, 12, 12, 1314091920, 60, 12, 12, 1314091980, 60, 12, 12, 1314092040, 60, 12, 12, 1314092100, 60, 12, 12, 1314092160, 60, 12, 12, 1314092220, 60, 12, 12, 1314092280, 60, 12, 12, 1314092340, 60, 12, 12, 1314092400, 60, 12, 12, 1314092460, 60, 12, 12, 1314092520, 60, 12, 12, 1314092580, 60, 12, 12, 1314092640, 60, 12, 12, 1314092700, 60, 12, 12, 1314092760, 60, 12, 12, 1314092820, 60, 12, 12, 1314092880, 60, 12, 12, 1314092940, 60, 12, 12, 1314093000, 60, 12, 12, 1314093060, 60, 12, 12, 1314093120, 60, 12, 12, 1314093180, 60, 12, 12, 1314093240, 60, 12, 12, 1314093300, 60, 12, 12, 1314093360, 60, 12, 12, 1314093420, 60, 12, 12, 1314093480, 60, 12, 12, 1314093540, 60, 12, 12, 1314093600, 60, 12, 12, 1314093660, 60, 12, 12, 1314093720, 60, 12, 12, 1314093780, 60, 12, 12, 1314093840, 60, 12, 12, 1314093900, 60, 12, 12, 1314093960, 60, 12, 12, 1314094020, 60, 12, 12, 1314094080, 60, 12, 12, 1314094140, 60, 12, 12, 1314094200, 60, 12, 12, 1314094260, 60, 12, 12, 1314094320, 60, 12, 12, 1314094380, 60, 12, 12, 1314094440, 60, 12, 12, 1314094500, 60, 12, 12, 1314094560, 60, 12, 12, 1314094620, 60, 12, 12, 1314094680, 60, 12, 12, 1314094740, 60, 12, 12, 1314094800, 60, 12, 12, 1314094860, 60, 12, 12, 1314094920, 60, 12, 12, 1314094980, 60, 12, 12, 1314095040, 60, 12, 12, 1314095100, 60, 12, 12, 1314095160, 60, 12, 12, 1314095220, 60, 12, 12, 1314095280, 60, 12, 12, 1314095340, 60, 12, 12, 1314095400, 60, 12, 12, 1314095460, 60, 12, 12, 1314095520, 60, 12, 12, 1314095580, 60, 12, 12, 1314095640, 60, 12, 12, 1314095700, 60, 12, 12, 1314095760, 60, 12, 12, 1314095820, 60, 12, 12, 1314095880, 60, 12, 12, 1314095940, 60, 12, 12, 1314096000, 60, 12, 12, 1314096060, 60, 12, 12, 1314096120, 60, 12, 12, 1314096180, 60, 12, 12, 1314096240, 60, 12, 12, 1314096300, 60, 11, 11, 1314096360, 60, 12, 12, 1314096420, 60, 12, 12, 1314096480, 60, 12, 12, 1314096540, 60, 12, 12, 1314096600, 60, 12, 12, 1314096660, 60, 12, 12, 1314096720, 60, 12, 12, 1314096780, 60, 12, 12, 1314096840, 60, 12, 12, 1314096900, 60, 12, 12, 1314096960, 60, 12, 12, 1314097020, 60, 12, 12, 1314097080, 60, 12, 12, 1314097140, 60, 12, 12, 1314097200, 60, 12, 12, 1314097260, 60, 12, 12, 1314097320, 60, 12, 12, 1314097380, 60, 12, 12, 1314097440, 60, 12, 12, 1314097500, 60, 12, 12, 1314097560, 60, 12, 12, 1314097620, 60, 12, 12, 1314097680, 60, 12, 12, 1314097740, 60, 12, 12, 1314097800, 60, 12, 12, 1314097860, 60, 12, 12, 1314097920, 60, 12, 12, 1314097980, 60, 12, 12, 1314098040, 60, 12, 12, 1314098100, 60, 12, 12, 1314098160, 60, 12, 12, 1314098220, 60, 12, 12, 1314098280, 60, 12, 12, 1314098340, 60, 12, 12, 1314098400, 60, 12, 12, 1314098460, 60, 12, 12, 1314098520, 60, 12, 12, 1314098580, 60, 12, 12, 1314098640, 60, 12, 12, 1314098700, 60, 12, 12, 1314098760, 60, 12, 12, 1314098820, 60, 12, 12, 1314098880, 60, 12, 12, 1314098940, 60, 12, 12, 1314099000, 60, 12, 12, 1314099060, 60, 12, 12, 1314099120, 60, 12, 12, 1314099180, 60, 12, 12, 1314099240, 60, 12, 12, 1314099300, 60, 12, 12, 1314099360, 60, 12, 12, 1314099420, 60, 12, 12, 1314099480, 60, 12, 12, 1314099540, 60, 12, 12, 1314099600, 60, 12, 12, 1314099660, 60, 12, 12, 1314099720, 60, 12, 12, 1314099780, 60, 12, 12, 1314099840, 60, 12, 12, 1314099900, 60, 12, 12, 1314099960, 60, 12, 12, 1314100020, 60, 12, 12, 1314100080, 60, 12, 12, 1314100140, 60, 12, 12, 1314100200, 60, 12, 12, 1314100260, 60, 12, 12, 1314100320, 60, 12, 12, 1314100380, 60, 12, 12, 1314100440, 60, 12, 12, 1314100500, 60, 12, 12, 1314100560, 60, 12, 12, 1314100620, 60, 12, 12, 1314100680, 60, 12, 12, 1314100740, 60, 12, 12, 1314100800, 60, 12, 12, 1314100860, 60, 12, 12, 1314100920, 60, 12, 12, 1314100980, 60, 12, 12, 1314101040, 60, 12, 12, 1314101100, 60, 12, 12, 1314101160, 60, 12, 12, 1314101220, 60, 12, 12, 1314101280, 60, 11, 11, 1314101340, 60, 12, 12, 1314101400, 60, 12, 12, 1314101460, 60, 12, 12, 1314101520, 60, 12, 12, 1314101580, 60, 12, 12, 1314101640, 60, 12, 12, 1314101700, 60, 12, 12, 1314101760, 60, 12, 12, 1314101820, 60, 12, 12, 1314101880, 60, 12, 12, 1314101940, 60, 12, 12, 1314102000, 60, 12, 12, 1314102060, 60, 12, 12, 1314102120, 60, 12, 12, 1314102180, 60, 12, 12, 1314102240, 60, 12, 12, 1314102300, 60, 12, 12, 1314102360, 60, 12, 12, 1314102420, 60, 12, 12, 1314102480, 60, 12, 12, 1314102540, 60, 12, 12, 1314102600, 60, 12, 12, 1314102660, 60, 12, 12, 1314102720, 60, 12, 12, 1314102780, 60, 12, 12, 1314102840, 60, 12, 12, 1314102900, 60, 12, 12, 1314102960, 60, 12, 12, 1314103020, 60, 12, 12, 1314103080, 60, 12, 12, 1314103140, 60, 12, 12, 1314103200, 60, 12, 12, 1314103260, 60, 12, 12, 1314103320, 60, 12, 12, 1314103380, 60, 12, 12, 1314103440, 60, 12, 12, 1314103500, 60, 12, 12, 1314103560, 60, 12, 12, 1314103620, 60, 12, 12, 1314103680, 60, 12, 12, 1314103740, 60, 12, 12, 1314103800, 60, 12, 12, 1314103860, 60, 12, 12, 1314103920, 60, 12, 12, 1314103980, 60, 12, 12, 1314104040, 60, 12, 12, 1314104100, 60, 12, 12, 1314104160, 60, 12, 12, 1314104220, 60, 12, 12, 1314104280, 60, 12, 12, 1314104340, 60, 12, 12, 1314104400, 60, 12, 12, 1314104460, 60, 12, 12, 1314104520, 60, 12, 12, 1314104580, 60, 12, 12, 1314104640, 60, 12, 12, 1314104700, 60, 12, 12, 1314104760, 60, 12, 12, 1314104820, 60, 12, 12, 1314104880, 60, 12, 12, 1314104940, 60, 12, 12, 1314105000, 60, 12, 12, 1314105060, 60, 12, 12, 1314105120, 60, 12, 12, 1314105180, 60, 12, 12, 1314105240, 60, 12, 12, 1314105300, 60, 12, 12, 1314105360, 60, 12, 12, 1314105420, 60, 12, 12, 1314105480, 60, 12, 12, 1314105540, 60, 12, 12, 1314105600, 60, 12, 12, 1314105660, 60, 12, 12, 1314105720, 60, 12, 12, 1314105780, 60, 12, 12, 1314105840, 60, 12, 12, 1314105900, 60, 12, 12, 1314105960, 60, 12, 12, 1314106020, 60, 12, 12, 1314106080, 60, 12, 12, 1314106140, 60, 12, 12, 1314106200, 60, 12, 12, 1314106260, 60, 11, 11, 1314106320, 60, 12, 12, 1314106380, 60, 12, 12, 1314106440, 60, 12, 12, 1314106500, 60, 12, 12, 1314106560, 60, 12, 12, 1314106620, 60, 12, 12, 1314106680, 60, 12, 12, 1314106740, 60, 12, 12, 1314106800, 60, 12, 12, 1314106860, 60, 12, 12, 1314106920, 60, 12, 12, 1314106980, 60, 12, 12, 1314107040, 60, 12, 12, 1314107100, 60, 12, 12, 1314107160, 60, 12, 12, 1314107220, 60, 12, 12, 1314107280, 60, 12, 12, 1314107340, 60, 12, 12, 1314107400, 60, 12, 12, 1314107460, 60, 12, 12, 1314107520, 60, 12, 12, 1314107580, 60, 12, 12, 1314107640, 60, 12, 12, 1314107700, 60, 12, 12, 1314107760, 60, 12, 12, 1314107820, 60, 12, 12, 1314107880, 60, 12, 12, 1314107940, 60, 12, 12, 1314108000, 60, 12, 12, 1314108060, 60, 12, 12, 1314108120, 60, 12, 12, 1314108180, 60, 12, 12, 1314108240, 60, 12, 12, 1314108300, 60, 12, 12, 1314108360, 60, 12, 12, 1314108420, 60, 12, 12, 1314108480, 60, 12, 12, 1314108540, 60, 12, 12, 1314108600, 60, 12, 12, 1314108660, 60, 12, 12, 1314108720, 60, 12, 12, 1314108780, 60, 12, 12, 1314108840, 60, 12, 12, 1314108900, 60, 12, 12, 1314108960, 60, 12, 12, 1314109020, 60, 12, 12, 1314109080, 60, 12, 12, 1314109140, 60, 12, 12, 1314109200, 60, 12, 12, 1314109260, 60, 12, 12, 1314109320, 60, 12, 12, 1314109380, 60, 12, 12, 1314109440, 60, 12, 12, 1314109500, 60, 12, 12, 1314109560, 60, 12, 12, 1314109620, 60, 12, 12, 1314109680, 60, 12, 12, 1314109740, 60, 12, 12, 1314109800, 60, 12, 12, 1314109860, 60, 12, 12, 1314109920, 60, 12, 12, 1314109980, 60, 12, 12, 1314110040, 60, 12, 12, 1314110100, 60, 12, 12, 1314110160, 60, 12, 12, 1314110220, 60, 12, 12, 1314110280, 60, 12, 12, 1314110340, 60, 12, 12, 1314110400, 60, 12, 12, 1314110460, 60, 12, 12, 1314110520, 60, 12, 12, 1314110580, 60, 12, 12, 1314110640, 60, 12, 12, 1314110700, 60, 12, 12, 1314110760, 60, 12, 12, 1314110820, 60, 12, 12, 1314110880, 60, 12, 12, 1314110940, 60, 12, 12, 1314111000, 60, 12, 12, 1314111060, 60, 12, 12, 1314111120, 60, 12, 12, 1314111180, 60, 12, 12, 1314111240, 60, 11, 11, 1314111300, 60, 12, 12, 1314111360, 60, 12, 12, 1314111420, 60, 12, 12, 1314111480, 60, 12, 12, 1314111540, 60, 12, 12, 1314111600, 60, 12, 12, 1314111660, 60, 12, 12, 1314111720, 60, 12, 12, 1314111780, 60, 12, 12, 1314111840, 60, 12, 12, 1314111900, 60, 12, 12, 1314111960, 60, 12, 12, 1314112020, 60, 12, 12, 1314112080, 60, 12, 12, 1314112140, 60, 12, 12, 1314112200, 60, 12, 12, 1314112260, 60, 12, 12, 1314112320, 60, 12, 12, 1314112380, 60, 12, 12, 1314112440, 60, 12, 12, 1314112500, 60, 12, 12, 1314112560, 60, 12, 12, 1314112620, 60, 12, 12, 1314112680, 60, 12, 12, 1314112740, 60, 12, 12, 1314112800, 60, 12, 12, 1314112860, 60, 12, 12, 1314112920, 60, 12, 12, 1314112980, 60, 12, 12, 1314113040, 60, 12, 12, 1314113100, 60, 12, 12, 1314113160, 60, 12, 12, 1314113220, 60, 12, 12, 1314113280, 60, 12, 12, 1314113340, 60, 12, 12, 1314113400, 60, 12, 12, 1314113460, 60, 12, 12, 1314113520, 60, 12, 12, 1314113580, 60, 12, 12, 1314113640, 60, 12, 12, 1314113700, 60, 12, 12, 1314113760, 60, 12, 12, 1314113820, 60, 12, 12, 1314113880, 60, 12, 12, 1314113940, 60, 12, 12, 1314114000, 60, 12, 12, 1314114060, 60, 12, 12, 1314114120, 60, 12, 12, 1314114180, 60, 12, 12, 1314114240, 60, 12, 12, 1314114300, 60, 12, 12, 1314114360, 60, 12, 12, 1314114420, 60, 12, 12, 1314114480, 60, 12, 12, 1314114540, 60, 12, 12, 1314114600, 60, 12, 12, 1314114660, 60, 12, 12, 1314114720, 60, 12, 12, 1314114780, 60, 12, 12, 1314114840, 60, 12, 12, 1314114900, 60, 12, 12, 1314114960, 60, 12, 12, 1314115020, 60, 12, 12, 1314115080, 60, 12, 12, 1314115140, 60, 12, 12, 1314115200, 60, 12, 12, 1314115260, 60, 12, 12, 1314115320, 60, 12, 12, 1314115380, 60, 12, 12, 1314115440, 60, 12, 12, 1314115500, 60, 12, 12, 1314115560, 60, 12, 12, 1314115620, 60, 12, 12, 1314115680, 60, 12, 12, 1314115740, 60, 12, 12, 1314115800, 60, 12, 12, 1314115860, 60, 12, 12, 1314115920, 60, 12, 12, 1314115980, 60, 12, 12, 1314116040, 60, 12, 12, 1314116100, 60, 12, 12, 1314116160, 60, 12, 12, 1314116220, 60, 11, 11, 1314116280, 60, 12, 12, 1314116340, 60, 12, 12, 1314116400, 60, 12, 12, 1314116460, 60, 12, 12, 1314116520, 60, 12, 12, 1314116580, 60, 12, 12, 1314116640, 60, 12, 12, 1314116700, 60, 12, 12, 1314116760, 60, 12, 12, 1314116820, 60, 12, 12, 1314116880, 60, 12, 12, 1314116940, 60, 12, 12, 1314117000, 60, 12, 12, 1314117060, 60, 12, 12, 1314117120, 60, 12, 12, 1314117180, 60, 12, 12, 1314117240, 60, 12, 12, 1314117300, 60, 12, 12, 1314117360, 60, 12, 12, 1314117420, 60, 12, 12, 1314117480, 60, 12, 12, 1314117540, 60, 12, 12, 1314117600, 60, 12, 12, 1314117660, 60, 12, 12, 1314117720, 60, 12, 12, 1314117780, 60, 12, 12, 1314117840, 60, 12, 12, 1314117900, 60, 12, 12, 1314117960, 60, 12, 12, 1314118020, 60, 12, 12, 1314118080, 60, 12, 12, 1314118140, 60, 12, 12, 1314118200, 60, 12, 12, 1314118260, 60, 12, 12, 1314118320, 60, 12, 12, 1314118380, 60, 12, 12, 1314118440, 60, 12, 12, 1314118500, 60, 12, 12, 1314118560, 60, 12, 12, 1314118620, 60, 12, 12, 1314118680, 60, 12, 12, 1314118740, 60, 12, 12, 1314118800, 60, 12, 12, 1314118860, 60, 12, 12, 1314118920, 60, 12, 12, 1314118980, 60, 12, 12, 1314119040, 60, 12, 12, 1314119100, 60, 12, 12, 1314119160, 60, 12, 12, 1314119220, 60, 12, 12, 1314119280, 60, 12, 12, 1314119340, 60, 12, 12, 1314119400, 60, 12, 12, 1314119460, 60, 12, 12, 1314119520, 60, 12, 12, 1314119580, 60, 12, 12, 1314119640, 60, 12, 12, 1314119700, 60, 12, 12, 1314119760, 60, 12, 12, 1314119820, 60, 12, 12, 1314119880, 60, 12, 12, 1314119940, 60, 12, 12, 1314120000, 60, 12, 12, 1314120060, 60, 12, 12, 1314120120, 60, 12, 12, 1314120180, 60, 12, 12, 1314120240, 60, 12, 12, 1314120300, 60, 12, 12, 1314120360, 60, 12, 12, 1314120420, 60, 12, 12, 1314120480, 60, 12, 12, 1314120540, 60, 12, 12, 1314120600, 60, 12, 12, 1314120660, 60, 12, 12, 1314120720, 60, 12, 12, 1314120780, 60, 12, 12, 1314120840, 60, 12, 12, 1314120900, 60, 12, 12, 1314120960, 60, 12, 12, 1314121020, 60, 12, 12, 1314121080, 60, 12, 12, 1314121140, 60, 12, 12, 1314121200, 60, 11, 11, 1314121260, 60, 12, 12, 1314121320, 60, 12, 12, 1314121380, 60, 12, 12, 1314121440, 60, 12, 12, 1314121500, 60, 12, 12, 1314121560, 60, 12, 12, 1314121620, 60, 12, 12, 1314121680, 60, 12, 12, 1314121740, 60, 12, 12, 1314121800, 60, 12, 12, 1314121860, 60, 12, 12, 1314121920, 60, 12, 12, 1314121980, 60, 12, 12, 1314122040, 60, 12, 12, 1314122100, 60, 12, 12, 1314122160, 60, 12, 12, 1314122220, 60, 12, 12, 1314122280, 60, 12, 12, 1314122340, 60, 12, 12, 1314122400, 60, 12, 12, 1314122460, 60, 12, 12, 1314122520, 60, 12, 12, 1314122580, 60, 12, 12, 1314122640, 60, 12, 12, 1314122700, 60, 12, 12, 1314122760, 60, 12, 12, 1314122820, 60, 12, 12, 1314122880, 60, 12, 12, 1314122940, 60, 12, 12, 1314123000, 60, 12, 12, 1314123060, 60, 12, 12, 1314123120, 60, 12, 12, 1314123180, 60, 12, 12, 1314123240, 60, 12, 12, 1314123300, 60, 12, 12, 1314123360, 60, 12, 12, 1314123420, 60, 12, 12, 1314123480, 60, 12, 12, 1314123540, 60, 12, 12, 1314123600, 60, 12, 12, 1314123660, 60, 12, 12, 1314123720, 60, 12, 12, 1314123780, 60, 12, 12, 1314123840, 60, 12, 12, 1314123900, 60, 12, 12, 1314123960, 60, 12, 12, 1314124020, 60, 12, 12, 1314124080, 60, 12, 12, 1314124140, 60, 12, 12, 1314124200, 60, 12, 12, 1314124260, 60, 12, 12, 1314124320, 60, 12, 12, 1314124380, 60, 12, 12, 1314124440, 60, 12, 12, 1314124500, 60, 12, 12, 1314124560, 60, 12, 12, 1314124620, 60, 12, 12, 1314124680, 60, 12, 12, 1314124740, 60, 12, 12, 1314124800, 60, 12, 12, 1314124860, 60, 12, 12, 1314124920, 60, 12, 12, 1314124980, 60, 12, 12, 1314125040, 60, 12, 12, 1314125100, 60, 12, 12, 1314125160, 60, 12, 12, 1314125220, 60, 12, 12, 1314125280, 60, 12, 12, 1314125340, 60, 12, 12, 1314125400, 60, 12, 12, 1314125460, 60, 12, 12, 1314125520, 60, 12, 12, 1314125580, 60, 12, 12, 1314125640, 60, 12, 12, 1314125700, 60, 12, 12, 1314125760, 60, 12, 12, 1314125820, 60, 12, 12, 1314125880, 60, 12, 12, 1314125940, 60, 12, 12, 1314126000, 60, 12, 12, 1314126060, 60, 12, 12, 1314126120, 60, 11, 11, 1314126180, 60, 12, 12, 1314126240, 60, 12, 12, 1314126300, 60, 12, 12, 1314126360, 60, 12, 12, 1314126420, 60, 12, 12, 1314126480, 60, 12, 12, 1314126540, 60, 12, 12, 1314126600, 60, 12, 12, 1314126660, 60, 12, 12, 1314126720, 60, 12, 12, 1314126780, 60, 12, 12, 1314126840, 60, 12, 12, 1314126900, 60, 12, 12, 1314126960, 60, 12, 12, 1314127020, 60, 12, 12, 1314127080, 60, 12, 12, 1314127140, 60, 12, 12, 1314127200, 60, 12, 12, 1314127260, 60, 12, 12, 1314127320, 60, 12, 12, 1314127380, 60, 12, 12, 1314127440, 60, 12, 12, 1314127500, 60, 11, 11, 1314127560, 60, 12, 12, 1314127620, 60, 12, 12, 1314127680, 60, 12, 12, 1314127740, 60, 12, 12, 1314127800, 60, 12, 12, 1314127860, 60, 12, 12, 1314127920, 60, 12, 12, 1314127980, 60, 12, 12, 1314128040, 60, 12, 12, 1314128100, 60, 12, 12, 1314128160, 60, 12, 12, 1314128220, 60, 12, 12, 1314128280, 60, 12, 12, 1314128340, 60, 12, 12, 1314128400, 60, 12, 12, 1314128460, 60, 12, 12, 1314128520, 60, 12, 12, 1314128580, 60, 12, 12, 1314128640, 60, 12, 12, 1314128700, 60, 12, 12, 1314128760, 60, 12, 12, 1314128820, 60, 12, 12, 1314128880, 60, 12, 12, 1314128940, 60, 12, 12, 1314129000, 60, 12, 12, 1314129060, 60, 12, 12, 1314129120, 60, 12, 12, 1314129180, 60, 12, 12, 1314129240, 60, 12, 12, 1314129300, 60, 12, 12, 1314129360, 60, 12, 12, 1314129420, 60, 12, 12, 1314129480, 60, 12, 12, 1314129540, 60, 12, 12, 1314129600, 60, 12, 12, 1314129660, 60, 12, 12, 1314129720, 60, 12, 12, 1314129780, 60, 12, 12, 1314129840, 60, 12, 12, 1314129900, 60, 12, 12, 1314129960, 60, 12, 12, 1314130020, 60, 12, 12, 1314130080, 60, 12, 12, 1314130140, 60, 12, 12, 1314130200, 60, 12, 12, 1314130260, 60, 12, 12, 1314130320, 60, 12, 12, 1314130380, 60, 12, 12, 1314130440, 60, 12, 12, 1314130500, 60, 12, 12, 1314130560, 60, 12, 12, 1314130620, 60, 12, 12, 1314130680, 60, 12, 12, 1314130740, 60, 12, 12, 1314130800, 60, 12, 12, 1314130860, 60, 12, 12, 1314130920, 60, 12, 12, 1314130980, 60, 12, 12, 1314131040, 60, 12, 12, 1314131100, 60, 12, 12, 1314131160, 60, 12, 12, 1314131220, 60, 12, 12, 1314131280, 60, 12, 12, 1314131340, 60, 12, 12, 1314131400, 60, 12, 12, 1314131460, 60, 12, 12, 1314131520, 60, 12, 12, 1314131580, 60, 12, 12, 1314131640, 60, 12, 12, 1314131700, 60, 12, 12, 1314131760, 60, 12, 12, 1314131820, 60, 12, 12, 1314131880, 60, 12, 12, 1314131940, 60, 12, 12, 1314132000, 60, 12, 12, 1314132060, 60, 12, 12, 1314132120, 60, 11, 11, 1314132180, 60, 12, 12, 1314132240, 60, 12, 12, 1314132300, 60, 12, 12, 1314132360, 60, 12, 12, 1314132420, 60, 12, 12, 1314132480, 60, 12, 12, 1314132540, 60, 12, 12, 1314132600, 60, 12, 12, 1314132660, 60, 12, 12, 1314132720, 60, 12, 12, 1314132780, 60, 12, 12, 1314132840, 60, 12, 12, 1314132900, 60, 12, 12, 1314132960, 60, 12, 12, 1314133020, 60, 12, 12, 1314133080, 60, 12, 12, 1314133140, 60, 12, 12, 1314133200, 60, 12, 12, 1314133260, 60, 12, 12, 1314133320, 60, 12, 12, 1314133380, 60, 12, 12, 1314133440, 60, 12, 12, 1314133500, 60, 12, 12, 1314133560, 60, 12, 12, 1314133620, 60, 12, 12, 1314133680, 60, 12, 12, 1314133740, 60, 12, 12, 1314133800, 60, 12, 12, 1314133860, 60, 12, 12, 1314133920, 60, 12, 12, 1314133980, 60, 12, 12, 1314134040, 60, 12, 12, 1314134100, 60, 12, 12, 1314134160, 60, 12, 12, 1314134220, 60, 12, 12, 1314134280, 60, 12, 12, 1314134340, 60, 12, 12, 1314134400, 60, 12, 12, 1314134460, 60, 12, 12, 1314134520, 60, 12, 12, 1314134580, 60, 12, 12, 1314134640, 60, 12, 12, 1314134700, 60, 12, 12, 1314134760, 60, 12, 12, 1314134820, 60, 12, 12, 1314134880, 60, 12, 12, 1314134940, 60, 12, 12, 1314135000, 60, 12, 12, 1314135060, 60, 12, 12, 1314135120, 60, 12, 12, 1314135180, 60, 12, 12, 1314135240, 60, 12, 12, 1314135300, 60, 12, 12, 1314135360, 60, 12, 12, 1314135420, 60, 12, 12, 1314135480, 60, 12, 12, 1314135540, 60, 12, 12, 1314135600, 60, 12, 12, 1314135660, 60, 12, 12, 1314135720, 60, 12, 12, 1314135780, 60, 12, 12, 1314135840, 60, 12, 12, 1314135900, 60, 12, 12, 1314135960, 60, 12, 12, 1314136020, 60, 12, 12, 1314136080, 60, 12, 12, 1314136140, 60, 12, 12, 1314136200, 60, 12, 12, 1314136260, 60, 12, 12, 1314136320, 60, 11, 11, 1314136380, 60, 12, 12, 1314136440, 60, 12, 12, 1314136500, 60, 12, 12, 1314136560, 60, 12, 12, 1314136620, 60, 12, 12, 1314136680, 60, 12, 12, 1314136740, 60, 12, 12, 1314136800, 60, 12, 12, 1314136860, 60, 12, 12, 1314136920, 60, 12, 12, 1314136980, 60, 12, 12, 1314137040, 60, 12, 12, 1314137100, 60, 12, 12, 1314137160, 60, 12, 12, 1314137220, 60, 12, 12, 1314137280, 60, 12, 12, 1314137340, 60, 12, 12, 1314137400, 60, 10, 10}
+func TestPackNilPointer(t *testing.T) {
+	b := new(bytes.Buffer)
+	var s *testStruct
+	n, err := Pack(b, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 || b.Bytes()[0] != 0xc0 {
+		t.Fatal("expected nil pointer to pack as nil")
+	}
+}
 
-func BenchmarkPackBigArray(b *testing.B) {
-	fmt.Printf("!!!benchmark testing!!!\n")
-	for i := 0; i < b.N; i++ {
+func TestUnmarshalStruct(t *testing.T) {
+	b := new(bytes.Buffer)
+	in := testStruct{Name: "bob", Age: 42, Tags: []string{"x", "y"}}
+	in.Zip = "60614"
+	in.Next = &testStruct{Name: "alice"}
+	_, err := Pack(b, in)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out testStruct
+	err = Unmarshal(b.Bytes(), &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.Name != "bob" || out.Age != 42 || out.Zip != "60614" {
+		t.Errorf("unmarshal didn't match: %+v", out)
+	}
+	if len(out.Tags) != 2 || out.Tags[1] != "y" {
+		t.Errorf("expected tags to be decoded, got %v", out.Tags)
+	}
+	if out.Next == nil || out.Next.Name != "alice" {
+		t.Errorf("expected Next to be decoded, got %v", out.Next)
+	}
+}
+
+func TestDecodeBasicTypes(t *testing.T) {
+	b := new(bytes.Buffer)
+	Pack(b, []interface{}{300, -500, 2.5, "hi", true, map[string]int{"a": 1}})
+
+	var n uint16
+	var i int32
+	var f float32
+	var s string
+	var ok bool
+	var m map[string]int64
+	var items []interface{}
+	err := NewPackReader(bytes.NewReader(b.Bytes())).Decode(&items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	targets := []interface{}{&n, &i, &f, &s, &ok, &m}
+	for j, target := range targets {
+		c := new(bytes.Buffer)
+		Pack(c, items[j])
+		err := Unmarshal(c.Bytes(), target)
+		if err != nil {
+			t.Fatalf("item %d: %s", j, err)
+		}
+	}
+	if n != 300 || i != -500 || f != 2.5 || s != "hi" || !ok || m["a"] != 1 {
+		t.Errorf("decoded values didn't match: %d %d %f %q %v %v", n, i, f, s, ok, m)
+	}
+}
+
+func TestUnmarshalTypeMismatch(t *testing.T) {
+	b := new(bytes.Buffer)
+	Pack(b, map[string]interface{}{"age": "old"})
+	var out testStruct
+	err := Unmarshal(b.Bytes(), &out)
+	e, ok := err.(*UnmarshalTypeError)
+	if !ok {
+		t.Fatalf("expected an UnmarshalTypeError, got %v", err)
+	}
+	if e.Field != "age" {
+		t.Errorf("expected error for field age, not %q", e.Field)
+	}
+
+	c := new(bytes.Buffer)
+	Pack(c, 300)
+	var small int8
+	if err := Unmarshal(c.Bytes(), &small); err == nil {
+		t.Error("expected overflow to be an error")
+	}
+	if err := Unmarshal(c.Bytes(), small); err == nil {
+		t.Error("expected non-pointer to be an error")
+	}
+}
+
+type testPoint struct {
+	X, Y int8
+}
+
+func init() {
+	err := RegisterExt(12, testPoint{},
+		func(value interface{}) ([]byte, error) {
+			p := value.(testPoint)
+			return []byte{byte(p.X), byte(p.Y)}, nil
+		},
+		func(data []byte) (interface{}, error) {
+			return testPoint{int8(data[0]), int8(data[1])}, nil
+		})
+	if err != nil {
+		panic(err)
+	}
+}
+
+func TestPackUnpackRegisteredExt(t *testing.T) {
+	b := new(bytes.Buffer)
+	n, err := Pack(b, []interface{}{testPoint{3, -4}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 5 || b.Bytes()[1] != 0xd5 || b.Bytes()[2] != 12 {
+		t.Fatalf("expected a fixext2 with type 12, got % x", b.Bytes())
+	}
+	data := b.Bytes()
+
+	x, _, err := Unpack(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, ok := NewArray(x).Item(0).(testPoint)
+	if !ok || p.X != 3 || p.Y != -4 {
+		t.Fatalf("expected testPoint{3, -4}, got %v", NewArray(x).Item(0))
+	}
+
+	var points []*testPoint
+	err = Unmarshal(data, &points)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if points[0].Y != -4 {
+		t.Errorf("expected decode into *testPoint, got %v", points[0])
+	}
+
+	if err := RegisterExt(12, 0, nil, nil); err == nil {
+		t.Error("expected duplicate registration to fail")
+	}
+}
+
+type testRGB struct{ R, G, B uint8 }
+
+// registration can happen while other goroutines pack and unpack
+func TestRegisterExtConcurrently(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				b := new(bytes.Buffer)
+				Pack(b, []interface{}{testPoint{1, 2}, time.Unix(5, 0), testRGB{}})
+				Unpack(b)
+			}
+		}()
+	}
+	err := RegisterExt(13, testRGB{},
+		func(value interface{}) ([]byte, error) {
+			c := value.(testRGB)
+			return []byte{c.R, c.G, c.B}, nil
+		},
+		func(data []byte) (interface{}, error) {
+			return testRGB{data[0], data[1], data[2]}, nil
+		})
+	wg.Wait()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := new(bytes.Buffer)
+	Pack(b, testRGB{1, 2, 3})
+	x, _, err := UnpackBytes(b.Bytes())
+	if err != nil || x != (testRGB{1, 2, 3}) {
+		t.Errorf("expected testRGB{1, 2, 3}, got %v, %v", x, err)
+	}
+}
+
+func TestUnpackUnknownExt(t *testing.T) {
+	for _, size := range []int{1, 3, 16, 300} {
 		b := new(bytes.Buffer)
-		Pack(b, big)
+		in := Ext{Type: 99, Data: bytes.Repeat([]byte{7}, size)}
+		_, err := Pack(b, in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		x, _, err := Unpack(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, ok := x.(Ext)
+		if !ok || out.Type != 99 || !bytes.Equal(out.Data, in.Data) {
+			t.Errorf("ext of size %d didn't round trip: %v", size, x)
+		}
+	}
+}
+
+func TestPackUnpackTimestamp(t *testing.T) {
+	cases := []struct {
+		in   time.Time
+		size int
+	}{
+		{time.Unix(1314136620, 0), 6},
+		{time.Unix(1314136620, 500), 10},
+		{time.Unix(-86400, 7), 15},
+	}
+	for _, c := range cases {
+		b := new(bytes.Buffer)
+		n, err := Pack(b, c.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != c.size {
+			t.Errorf("expected %v to pack to %d bytes, not %d", c.in, c.size, n)
+		}
+		x, _, err := Unpack(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !x.(time.Time).Equal(c.in) {
+			t.Errorf("expected %v, got %v", c.in, x)
+		}
+	}
+}
+
+func TestTimeAccessors(t *testing.T) {
+	now := time.Unix(1314136620, 123456789)
+	b := new(bytes.Buffer)
+	Pack(b, []interface{}{map[string]interface{}{"at": now}, now})
+	x, _, err := Unpack(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !NewArray(x).TimeItem(1).Equal(now) {
+		t.Error("expected TimeItem to return the packed time")
+	}
+	at, present := NewParams(x).TimeIndex("at")
+	if !present || !at.Equal(now) {
+		t.Errorf("expected TimeIndex to return the packed time, got %v", at)
+	}
+}
+
+func TestUnpackOneByteAtATime(t *testing.T) {
+	b := new(bytes.Buffer)
+	in := []interface{}{"hello", make([]byte, 70000), map[string]interface{}{"n": 1234567}}
+	Pack(b, in)
+	Pack(b, "second")
+
+	pr := NewPackReader(iotest.OneByteReader(b))
+	var out []interface{}
+	err := pr.Decode(&out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out) != 3 || len(out[1].([]byte)) != 70000 {
+		t.Fatalf("unexpected value: %v", out)
+	}
+	var second string
+	err = pr.Decode(&second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second != "second" {
+		t.Errorf("expected the second value, not %q", second)
+	}
+	var extra interface{}
+	if err := pr.Decode(&extra); err != io.EOF {
+		t.Errorf("expected io.EOF at the end of the stream, not %v", err)
+	}
+}
+
+func TestUnpackTruncated(t *testing.T) {
+	b := new(bytes.Buffer)
+	Pack(b, []interface{}{"hello", make([]byte, 1000), uint32(100000)})
+	data := b.Bytes()
+	for _, size := range []int{1, 4, 500, len(data) - 1} {
+		_, _, err := Unpack(iotest.OneByteReader(bytes.NewReader(data[:size])))
+		if err != io.ErrUnexpectedEOF {
+			t.Errorf("expected io.ErrUnexpectedEOF for %d bytes, not %v", size, err)
+		}
+	}
+}
+
+func TestDecoderLimits(t *testing.T) {
+	cases := []struct {
+		data    []byte
+		options DecoderOptions
+		limit   string
+	}{
+		{[]byte{0xdd, 0xff, 0xff, 0xff, 0xff}, DecoderOptions{MaxArrayLength: 1000}, "array length"},
+		{[]byte{0xdf, 0xff, 0xff, 0xff, 0xff}, DecoderOptions{MaxMapLength: 1000}, "map length"},
+		{[]byte{0xc6, 0xff, 0xff, 0xff, 0xff}, DecoderOptions{MaxRawLength: 1000}, "raw length"},
+		{[]byte{0xdb, 0xff, 0xff, 0xff, 0xff}, DecoderOptions{MaxBytes: 1000}, "bytes"},
+		{[]byte{0x91, 0x91, 0x91, 0x01}, DecoderOptions{MaxDepth: 2}, "depth"},
+	}
+	for _, c := range cases {
+		pr := NewPackReaderOptions(bytes.NewReader(c.data), c.options)
+		var v interface{}
+		err := pr.Decode(&v)
+		e, ok := err.(*LimitError)
+		if !ok {
+			t.Errorf("expected a LimitError for % x, got %v", c.data, err)
+			continue
+		}
+		if e.Limit != c.limit {
+			t.Errorf("expected the %s limit to be hit, not %s", c.limit, e.Limit)
+		}
+	}
+
+	// fixed width elements count towards MaxBytes too
+	floats := new(bytes.Buffer)
+	Pack(floats, make([]float64, 10))
+	options := DecoderOptions{MaxBytes: 20}
+	var fs []float64
+	err := NewPackReaderOptions(bytes.NewReader(floats.Bytes()), options).Decode(&fs)
+	if e, ok := err.(*LimitError); !ok || e.Limit != "bytes" {
+		t.Errorf("expected a bytes LimitError decoding floats, got %v", err)
+	}
+	_, _, err = UnpackBytesOptions(floats.Bytes(), options)
+	if e, ok := err.(*LimitError); !ok || e.Limit != "bytes" {
+		t.Errorf("expected a bytes LimitError unpacking floats, got %v", err)
+	}
+	options.MaxBytes = floats.Len()
+	_, _, err = UnpackBytesOptions(floats.Bytes(), options)
+	if err != nil {
+		t.Errorf("floats at exactly MaxBytes: %v", err)
+	}
+
+	// the limits apply to each top level value, not the whole stream
+	b := new(bytes.Buffer)
+	Pack(b, []int{1, 2, 3})
+	Pack(b, []int{4, 5, 6})
+	pr := NewPackReaderOptions(b, DecoderOptions{MaxBytes: 4, MaxDepth: 1})
+	for i := 0; i < 2; i++ {
+		var v []int
+		if err := pr.Decode(&v); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+type (
+	testStatus int
+	testColor  string
+	testRatio  float32
+	testFlag   bool
+	testCode   uint16
+)
+
+func TestPackNamedBasicTypes(t *testing.T) {
+	type named struct {
+		Status testStatus
+		Color  testColor
+		Ratio  testRatio
+		Flag   testFlag
+		Code   testCode
+	}
+	type plain struct {
+		Status int
+		Color  string
+		Ratio  float32
+		Flag   bool
+		Code   uint16
+	}
+	in := named{Status: -3, Color: "red", Ratio: 0.5, Flag: true, Code: 300}
+	b := new(bytes.Buffer)
+	n, err := Pack(b, in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := new(bytes.Buffer)
+	Pack(expected, plain{-3, "red", 0.5, true, 300})
+	if !bytes.Equal(b.Bytes(), expected.Bytes()) {
+		t.Errorf("named types packed as % x, not % x", b.Bytes(), expected.Bytes())
+	}
+	size, err := EncodedSize(in)
+	if err != nil || size != n {
+		t.Errorf("EncodedSize %d, %v, packed %d", size, err, n)
+	}
+
+	var out named
+	err = Unmarshal(b.Bytes(), &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != in {
+		t.Errorf("expected %+v, got %+v", in, out)
+	}
+}
+
+// nested headers each claiming a million elements, in a few hundred bytes
+func TestDecoderNestedLengths(t *testing.T) {
+	data := bytes.Repeat([]byte{0xdd, 0x00, 0x0f, 0xff, 0xff}, 99)
+	allocated := func(decode func() error) uint64 {
+		var before, after runtime.MemStats
+		runtime.ReadMemStats(&before)
+		err := decode()
+		runtime.ReadMemStats(&after)
+		if err == nil {
+			t.Error("expected an error for truncated data")
+		}
+		return after.TotalAlloc - before.TotalAlloc
+	}
+
+	n := allocated(func() error {
+		_, _, err := UnpackBytesOptions(data, DefaultDecoderOptions)
+		return err
+	})
+	if n > 16<<20 {
+		t.Errorf("Unpack allocated %d bytes", n)
+	}
+	n = allocated(func() error {
+		var v [][][]interface{}
+		return NewPackReaderOptions(bytes.NewReader(data), DefaultDecoderOptions).Decode(&v)
+	})
+	if n > 16<<20 {
+		t.Errorf("Decode allocated %d bytes", n)
+	}
+	n = allocated(func() error {
+		var v []float64
+		return NewPackReaderOptions(bytes.NewReader(data[:5]), DefaultDecoderOptions).Decode(&v)
+	})
+	if n > 1<<20 {
+		t.Errorf("Decode into []float64 allocated %d bytes", n)
+	}
+}
+
+func TestPackUnsupportedType(t *testing.T) {
+	b := new(bytes.Buffer)
+	_, err := Pack(b, []interface{}{1, make(chan int)})
+	var e *UnsupportedTypeError
+	if !errors.As(err, &e) {
+		t.Fatalf("expected an UnsupportedTypeError, got %v", err)
+	}
+	if e.Type != reflect.TypeOf(make(chan int)) {
+		t.Errorf("expected the error to name chan int, not %v", e.Type)
+	}
+}
+
+func TestUnpackInvalidPrefix(t *testing.T) {
+	_, _, err := Unpack(bytes.NewReader([]byte{0x93, 0x01, 0x7f, 0xc1}))
+	var e *InvalidPrefixError
+	if !errors.As(err, &e) {
+		t.Fatalf("expected an InvalidPrefixError, got %v", err)
+	}
+	if e.Prefix != 0xc1 || e.Offset != 3 {
+		t.Errorf("expected prefix c1 at offset 3, not %x at %d", e.Prefix, e.Offset)
+	}
+}
+
+func TestUnpackUnhashableKey(t *testing.T) {
+	tests := []struct {
+		data   []byte
+		offset int
+	}{
+		{[]byte{0x81, 0xd4, 0x05, 0x01, 0x01}, 1},
+		{[]byte{0x82, 0x01, 0x02, 0x91, 0x01, 0x01}, 3},
+		{[]byte{0x81, 0x81, 0x80, 0x01, 0x02}, 2},
+	}
+	for _, test := range tests {
+		_, _, err := Unpack(bytes.NewReader(test.data))
+		var e *UnhashableKeyError
+		if !errors.As(err, &e) || e.Offset != test.offset {
+			t.Errorf("% x: expected an UnhashableKeyError at %d, got %v", test.data, test.offset, err)
+		}
+
+		var m map[interface{}]int
+		err = Unmarshal(test.data, &m)
+		if !errors.As(err, &e) || e.Offset != test.offset {
+			t.Errorf("% x: Unmarshal expected an UnhashableKeyError at %d, got %v", test.data, test.offset, err)
+		}
+	}
+}
+
+type failingWriter struct {
+	remaining int
+}
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if len(p) > w.remaining {
+		n := w.remaining
+		w.remaining = 0
+		return n, errors.New("write failed")
+	}
+	w.remaining -= len(p)
+	return len(p), nil
+}
+
+func TestPackWriteErrors(t *testing.T) {
+	values := []interface{}{"short", make([]byte, 40), make([]byte, 70000), string(make([]byte, 70000))}
+	for _, v := range values {
+		_, err := Pack(&failingWriter{remaining: 2}, v)
+		if err == nil {
+			t.Errorf("expected write error packing %T to be returned", v)
+		}
+	}
+}
+
+func TestNextToken(t *testing.T) {
+	b := new(bytes.Buffer)
+	Pack(b, []interface{}{nil, true, -200, uint16(500), 1.5, "abc", []byte{1, 2}, map[string]int{"k": 1}, Ext{Type: 5, Data: []byte{9}}})
+	pr := NewPackReader(b)
+
+	expected := []struct {
+		kind TokenKind
+		len  int
+	}{
+		{ArrayToken, 9}, {NilToken, 0}, {BoolToken, 0}, {IntToken, 0}, {UintToken, 0}, {FloatToken, 0},
+		{StringToken, 3}, {BinToken, 2}, {MapToken, 1}, {StringToken, 1}, {UintToken, 0}, {ExtToken, 1},
+	}
+	for i, e := range expected {
+		tok, err := pr.NextToken()
+		if err != nil {
+			t.Fatalf("token %d: %s", i, err)
+		}
+		if tok.Kind != e.kind || tok.Len != e.len {
+			t.Errorf("token %d: expected %s of length %d, got %s of length %d", i, e.kind, e.len, tok.Kind, tok.Len)
+		}
+	}
+	if _, err := pr.NextToken(); err != io.EOF {
+		t.Errorf("expected io.EOF after the last token, not %v", err)
+	}
+}
+
+func TestSkip(t *testing.T) {
+	b := new(bytes.Buffer)
+	Pack(b, map[string]interface{}{"big": make([]byte, 100000), "list": []interface{}{1, "two", []int{3}}})
+	Pack(b, "after")
+	pr := NewPackReader(b)
+	if err := pr.Skip(); err != nil {
+		t.Fatal(err)
+	}
+	tok, err := pr.NextToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tok.Value != "after" {
+		t.Errorf("expected skip to stop at the next value, got %v", tok.Value)
+	}
+
+	c := bytes.NewReader([]byte{0x92, 0xc4, 0x10, 0x01})
+	if err := NewPackReader(c).Skip(); err != io.ErrUnexpectedEOF {
+		t.Errorf("expected io.ErrUnexpectedEOF skipping a truncated value, not %v", err)
+	}
+}
+
+func TestStreamingWrites(t *testing.T) {
+	b := new(bytes.Buffer)
+	pw := NewPackWriter(b)
+	total := 0
+	add := func(n int, err error) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		total += n
+	}
+	add(pw.WriteArrayHeader(20))
+	for i := 0; i < 20; i++ {
+		add(pw.WriteInt(int64(i * -1000)))
+	}
+	add(pw.WriteMapHeader(6))
+	add(pw.WriteString("s"))
+	add(pw.WriteBytes([]byte{1}))
+	add(pw.WriteString("u"))
+	add(pw.WriteUint(1 << 40))
+	add(pw.WriteString("f"))
+	add(pw.WriteFloat(0.25))
+	add(pw.WriteString("b"))
+	add(pw.WriteBool(true))
+	add(pw.WriteString("n"))
+	add(pw.WriteNil())
+	add(pw.WriteString("v"))
+	add(pw.Encode([]string{"x"}))
+	if total != b.Len() {
+		t.Errorf("expected writes to report %d bytes, not %d", b.Len(), total)
+	}
+
+	var list []int
+	var m map[string]interface{}
+	pr := NewPackReader(b)
+	if err := pr.Decode(&list); err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 20 || list[19] != -19000 {
+		t.Errorf("unexpected array: %v", list)
+	}
+	if err := pr.Decode(&m); err != nil {
+		t.Fatal(err)
+	}
+	if m["u"] != uint64(1<<40) || m["f"] != 0.25 || m["b"] != true || m["n"] != nil || len(m) != 6 {
+		t.Errorf("unexpected map: %v", m)
+	}
+
+	if _, err := pw.WriteArrayHeader(-1); err == nil {
+		t.Error("expected a negative length to be an error")
+	}
+}
+
+// packed as a "dollars.cents" string
+type testMoney int64
+
+func (m testMoney) MarshalMsgpack() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Pack(b, fmt.Sprintf("%d.%02d", m/100, m%100))
+	return b.Bytes(), err
+}
+
+func (m *testMoney) UnmarshalMsgpack(data []byte) error {
+	var s string
+	err := Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+	var dollars, cents int64
+	_, err = fmt.Sscanf(s, "%d.%d", &dollars, &cents)
+	*m = testMoney(dollars*100 + cents)
+	return err
+}
+
+// only the pointer type is a marshaler
+type testID struct {
+	n int
+}
+
+func (id *testID) MarshalMsgpack() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Pack(b, []int{id.n, id.n})
+	return b.Bytes(), err
+}
+
+func (id *testID) UnmarshalMsgpack(data []byte) error {
+	var pair []int
+	err := Unmarshal(data, &pair)
+	if err == nil {
+		id.n = pair[0]
+	}
+	return err
+}
+
+type testInvoice struct {
+	ID     testID
+	Total  testMoney
+	Refund *testMoney
+	Lines  []testMoney
+}
+
+func TestMarshalers(t *testing.T) {
+	in := testInvoice{ID: testID{7}, Total: 1234, Lines: []testMoney{5, 250}}
+	b := new(bytes.Buffer)
+	_, err := Pack(b, in)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	x, _, err := Unpack(bytes.NewReader(b.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := NewMap(x)
+	if total, _ := m.StringIndex("Total"); total != "12.34" {
+		t.Errorf("expected Total to be packed by its marshaler, got %q", total)
+	}
+	if id, _ := m.ArrayIndex("ID"); id == nil || id.Len() != 2 {
+		t.Errorf("expected ID to be packed by its pointer marshaler")
+	}
+
+	var out testInvoice
+	err = Unmarshal(b.Bytes(), &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.ID.n != 7 || out.Total != 1234 || out.Refund != nil || len(out.Lines) != 2 || out.Lines[1] != 250 {
+		t.Errorf("unmarshalers didn't round trip: %+v", out)
+	}
+
+	// a nil interface has no type to unmarshal into
+	var holder struct{ U MsgpackUnmarshaler }
+	err = Unmarshal([]byte{0x81, 0xa1, 'U', 0x01}, &holder)
+	if _, ok := err.(*UnmarshalTypeError); !ok {
+		t.Errorf("expected an *UnmarshalTypeError, got %v", err)
+	}
+	holder.U = new(testMoney)
+	err = Unmarshal([]byte{0x81, 0xa1, 'U', 0xa4, '1', '.', '2', '5'}, &holder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *holder.U.(*testMoney) != 125 {
+		t.Errorf("expected the interface's unmarshaler to be used, got %v", *holder.U.(*testMoney))
+	}
+}
+
+func TestAppendMatchesPack(t *testing.T) {
+	values := []interface{}{
+		nil, true, false, 0, 127, 128, 255, 256, -32, -33, -128, -129, 40000, -40000, 1 << 33, -(1 << 33),
+		uint8(200), uint16(60000), uint32(1 << 31), uint64(1 << 60), float32(1.5), 2.25,
+		"", "short", string(make([]byte, 100)), string(make([]byte, 300)), string(make([]byte, 70000)),
+		[]byte{}, make([]byte, 300), make([]byte, 70000), []int64{1, -1, 1 << 40},
+		[]interface{}{1, "a", []interface{}{nil}}, make([]interface{}, 20),
+		map[string]interface{}{"a": 1}, Ext{Type: 3, Data: make([]byte, 5)}, testStruct{Name: "x"},
+	}
+	for _, v := range values {
+		b := new(bytes.Buffer)
+		_, err := Pack(b, v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		appended, err := AppendValue([]byte{0xff}, v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(appended[1:], b.Bytes()) {
+			t.Errorf("AppendValue(%T) = % x, Pack wrote % x", v, appended[1:], b.Bytes())
+		}
+	}
+
+	dst := AppendMapHeader(nil, 2)
+	dst = AppendString(dst, "n")
+	dst = AppendUint(dst, 3)
+	dst = AppendString(dst, "list")
+	dst = AppendArrayHeader(dst, 1)
+	dst = AppendExt(dst, 99, []byte{1, 2, 3})
+	var out struct {
+		N    int
+		List []Ext
+	}
+	if err := Unmarshal(dst, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.N != 3 || len(out.List) != 1 || out.List[0].Type != 99 {
+		t.Errorf("unexpected value from appended headers: %+v", out)
+	}
+}
+
+func TestAppendValueAllocs(t *testing.T) {
+	var values interface{} = big
+	buf, _ := AppendValue(nil, values)
+	allocs := testing.AllocsPerRun(100, func() {
+		AppendValue(buf[:0], values)
+	})
+	if allocs != 0 {
+		t.Errorf("expected AppendValue into a large enough buffer not to allocate, got %v allocations", allocs)
+	}
+}
+
+func packCanonical(t *testing.T, value interface{}) []byte {
+	b := new(bytes.Buffer)
+	pw := NewPackWriter(b)
+	pw.Canonical = true
+	_, err := pw.Encode(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b.Bytes()
+}
+
+func TestCanonicalEncoding(t *testing.T) {
+	m := make(map[interface{}]interface{})
+	for i := 0; i < 50; i++ {
+		m[fmt.Sprintf("key%d", i)] = map[int]string{i: "a", -i: "b", i * 1000: "c"}
+		m[i*7] = i
+	}
+	first := packCanonical(t, m)
+	for i := 0; i < 10; i++ {
+		if !bytes.Equal(first, packCanonical(t, m)) {
+			t.Fatal("expected canonical encoding to be the same every time")
+		}
+	}
+
+	pr := NewPackReader(bytes.NewReader(packCanonical(t, map[string]int{"b": 2, "a": 1, "c": 3})))
+	tok, _ := pr.NextToken()
+	keys := ""
+	for i := 0; i < tok.Len; i++ {
+		k, _ := pr.NextToken()
+		keys += k.Value.(string)
+		pr.Skip()
+	}
+	if keys != "abc" {
+		t.Errorf("expected keys in sorted order, got %s", keys)
+	}
+
+	if packed := packCanonical(t, int16(200)); !bytes.Equal(packed, []byte{0xcc, 200}) {
+		t.Errorf("expected int16(200) to use the uint8 form, got % x", packed)
+	}
+	if !bytes.Equal(packCanonical(t, int64(70000)), packCanonical(t, uint32(70000))) {
+		t.Error("expected equal signed and unsigned values to pack the same")
+	}
+}
+
+func TestUnpackEmptyRaw(t *testing.T) {
+	b := new(bytes.Buffer)
+	Pack(b, []interface{}{"", []byte{}, nil})
+	data := b.Bytes()
+
+	x, _, err := Unpack(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	arr := NewArray(x)
+	if s, ok := arr.Item(0).(string); !ok || s != "" {
+		t.Errorf("expected an empty string, got %#v", arr.Item(0))
+	}
+	if bin, ok := arr.Item(1).([]byte); !ok || bin == nil || len(bin) != 0 {
+		t.Errorf("expected an empty []byte, got %#v", arr.Item(1))
+	}
+	if arr.Item(2) != nil {
+		t.Errorf("expected nil, got %#v", arr.Item(2))
+	}
+	if arr.StringItem(0) != "" || arr.StringItem(2) != "" {
+		t.Error("expected StringItem to return empty strings")
+	}
+
+	pr := NewPackReaderOptions(bytes.NewReader(data), DecoderOptions{EmptyRawAsNil: true})
+	var legacy []interface{}
+	if err := pr.Decode(&legacy); err != nil {
+		t.Fatal(err)
+	}
+	if legacy[0] != nil || legacy[1] != nil {
+		t.Errorf("expected empty raws to be nil with EmptyRawAsNil, got %#v", legacy)
+	}
+	if NewArray(legacy).StringItem(0) != "" {
+		t.Error("expected StringItem of nil to be empty")
+	}
+}
+
+func TestUnpackNegativeFixnum(t *testing.T) {
+	x, _, err := Unpack(bytes.NewReader([]byte{0xff}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x != int8(-1) {
+		t.Errorf("expected int8(-1), got %#v", x)
+	}
+	x, _, _ = Unpack(bytes.NewReader([]byte{0xe0}))
+	if x != int8(-32) {
+		t.Errorf("expected int8(-32), got %#v", x)
+	}
+}
+
+func TestUnpackNormalizeNumbers(t *testing.T) {
+	b := new(bytes.Buffer)
+	Pack(b, []interface{}{5, -5, 300, -300, uint64(math.MaxUint64), float32(1.5), 2.5})
+
+	pr := NewPackReaderOptions(b, DecoderOptions{NormalizeNumbers: true})
+	var x interface{}
+	if err := pr.Decode(&x); err != nil {
+		t.Fatal(err)
+	}
+	expected := []interface{}{int64(5), int64(-5), int64(300), int64(-300), uint64(math.MaxUint64), float64(1.5), float64(2.5)}
+	if !reflect.DeepEqual(x, expected) {
+		t.Errorf("expected %#v, got %#v", expected, x)
+	}
+}
+
+func TestReadMethods(t *testing.T) {
+	b := new(bytes.Buffer)
+	Pack(b, []interface{}{-5, uint16(300), 2.5, "hi", []byte{1}, true, nil, map[string]int{"a": 1}})
+	pr := NewPackReader(b)
+
+	n, err := pr.ReadArrayHeader()
+	if err != nil || n != 8 {
+		t.Fatalf("expected an array of 8, got %d %v", n, err)
+	}
+	i, _ := pr.ReadInt()
+	u, _ := pr.ReadUint()
+	f, _ := pr.ReadFloat()
+	s, _ := pr.ReadString()
+	bin, _ := pr.ReadBytes()
+	ok, _ := pr.ReadBool()
+	if i != -5 || u != 300 || f != 2.5 || s != "hi" || !bytes.Equal(bin, []byte{1}) || !ok {
+		t.Errorf("got %v %v %v %v %v %v", i, u, f, s, bin, ok)
+	}
+	n, err = pr.ReadMapHeader()
+	if err != nil || n != -1 {
+		t.Errorf("expected -1 for nil, got %d %v", n, err)
+	}
+	_, err = pr.ReadString()
+	if _, ok := err.(*UnmarshalTypeError); !ok {
+		t.Errorf("expected an *UnmarshalTypeError reading a map as a string, got %v", err)
+	}
+}
+
+func TestInspect(t *testing.T) {
+	b := new(bytes.Buffer)
+	Pack(b, []interface{}{-3, "hi", map[string]int{"a": 300}, []byte{1, 2}})
+	data := b.Bytes()
+
+	out := new(bytes.Buffer)
+	err := Inspect(bytes.NewReader(data), out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `00000000  94  fix_array len=4
+00000001  fd    negative_fix -3
+00000002  a2    fix_raw len=2 "hi"
+00000005  81    fix_map len=1
+00000006  a1      fix_raw len=1 "a"
+00000008  d1      int16 300
+0000000b  c4    bin8 len=2 0102
+`
+	if out.String() != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, out.String())
+	}
+
+	out.Reset()
+	err = Inspect(bytes.NewReader(data[:9]), out)
+	if err != io.ErrUnexpectedEOF {
+		t.Errorf("expected io.ErrUnexpectedEOF, got %v", err)
+	}
+	if !bytes.HasSuffix(out.Bytes(), []byte("00000009  !!  truncated int16 at 00000008\n")) {
+		t.Errorf("expected the truncation to be marked, got\n%s", out.String())
+	}
+
+	out.Reset()
+	err = Inspect(bytes.NewReader([]byte{0x92, 0x01, 0xc1}), out)
+	if _, ok := err.(*InvalidPrefixError); !ok {
+		t.Errorf("expected an *InvalidPrefixError, got %v", err)
+	}
+	if !bytes.HasSuffix(out.Bytes(), []byte("00000002  !!  invalid prefix 0xc1\n")) {
+		t.Errorf("expected the invalid prefix to be marked, got\n%s", out.String())
+	}
+
+	// long payloads are previewed without being held in memory
+	b.Reset()
+	Pack(b, []interface{}{strings.Repeat("x", 40), make([]byte, 16<<20)})
+	out.Reset()
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	err = Inspect(bytes.NewReader(b.Bytes()), out)
+	runtime.ReadMemStats(&after)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = `00000000  92  fix_array len=2
+00000001  d9    str8 len=40 "` + strings.Repeat("x", 32) + `"...
+0000002b  c6    bin32 len=16777216 ` + strings.Repeat("00", 32) + `...
+`
+	if out.String() != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, out.String())
+	}
+	if allocated := after.TotalAlloc - before.TotalAlloc; allocated > 1<<20 {
+		t.Errorf("expected Inspect to allocate less than 1 MB, allocated %d bytes", allocated)
+	}
+}
+
+type testRequest struct {
+	Method string
+	Args   RawMessage
+}
+
+func TestRawMessage(t *testing.T) {
+	b := new(bytes.Buffer)
+	Pack(b, map[string]interface{}{"Method": "add", "Args": []interface{}{1, "two", 3.5}})
+
+	var req testRequest
+	err := Unmarshal(b.Bytes(), &req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.Method != "add" {
+		t.Errorf("expected method add, got %q", req.Method)
+	}
+	args := new(bytes.Buffer)
+	Pack(args, []interface{}{1, "two", 3.5})
+	if !bytes.Equal(req.Args, args.Bytes()) {
+		t.Errorf("expected the raw args % x, got % x", args.Bytes(), []byte(req.Args))
+	}
+
+	// packing the request again writes the args verbatim
+	out := new(bytes.Buffer)
+	Pack(out, []interface{}{req.Args, RawMessage(nil)})
+	expected := append(append([]byte{0x92}, args.Bytes()...), 0xc0)
+	if !bytes.Equal(out.Bytes(), expected) {
+		t.Errorf("expected % x, got % x", expected, out.Bytes())
+	}
+
+	pr := NewPackReader(bytes.NewReader(expected))
+	pr.ReadArrayHeader()
+	raw, err := pr.ReadRawMessage()
+	if err != nil || !bytes.Equal(raw, args.Bytes()) {
+		t.Errorf("expected % x, got % x %v", args.Bytes(), []byte(raw), err)
+	}
+	raw, err = pr.ReadRawMessage()
+	if err != nil || !bytes.Equal(raw, []byte{0xc0}) {
+		t.Errorf("expected c0, got % x %v", []byte(raw), err)
+	}
+	_, err = pr.ReadRawMessage()
+	if err != io.EOF {
+		t.Errorf("expected io.EOF, got %v", err)
+	}
+}
+
+func TestExtract(t *testing.T) {
+	b := new(bytes.Buffer)
+	Pack(b, map[string]interface{}{
+		"method": "update",
+		"params": []interface{}{
+			map[interface{}]interface{}{"user": map[string]interface{}{"id": 42, "tags": []string{"a"}}, 7: "seven"},
+			"second",
+		},
+	})
+	data := b.Bytes()
+
+	cases := []struct {
+		path     []interface{}
+		expected interface{}
+	}{
+		{[]interface{}{"method"}, "update"},
+		{[]interface{}{"params", 1}, "second"},
+		{[]interface{}{"params", 0, "user", "id"}, uint8(42)},
+		{[]interface{}{"params", 0, 7}, "seven"},
+		{[]interface{}{"params", 0, "user", "tags", 0}, "a"},
+	}
+	for _, c := range cases {
+		v, err := Extract(data, c.path...)
+		if err != nil {
+			t.Errorf("%v: %v", c.path, err)
+			continue
+		}
+		if !reflect.DeepEqual(v, c.expected) {
+			t.Errorf("%v: expected %#v, got %#v", c.path, c.expected, v)
+		}
+	}
+
+	for _, path := range [][]interface{}{{"missing"}, {"params", 2}, {"params", "x"}, {"method", 0}} {
+		_, err := Extract(data, path...)
+		if err != ErrNotFound {
+			t.Errorf("%v: expected ErrNotFound, got %v", path, err)
+		}
+	}
+
+	_, err := Extract(data[:len(data)-2], "missing")
+	if err != io.ErrUnexpectedEOF {
+		t.Errorf("expected io.ErrUnexpectedEOF, got %v", err)
+	}
+}
+
+// Unpack must not read ahead into the next value of a plain reader
+func TestUnpackTwice(t *testing.T) {
+	b := new(bytes.Buffer)
+	Pack(b, "first")
+	Pack(b, []interface{}{"second", 2})
+	r := iotest.HalfReader(b)
+	for _, expected := range []interface{}{"first", []interface{}{"second", uint8(2)}} {
+		v, _, err := Unpack(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(v, expected) {
+			t.Errorf("expected %v, got %v", expected, v)
+		}
+	}
+	_, _, err := Unpack(r)
+	if err != io.EOF {
+		t.Errorf("expected io.EOF after the last value, got %v", err)
+	}
+}
+
+func TestUnpackBytes(t *testing.T) {
+	b := new(bytes.Buffer)
+	Pack(b, "first")
+	Pack(b, []interface{}{[]byte{1, 2, 3}, 300})
+	Pack(b, nil)
+	data := b.Bytes()
+
+	var values []interface{}
+	rest := data
+	for len(rest) > 0 {
+		var v interface{}
+		var err error
+		v, rest, err = UnpackBytes(rest)
+		if err != nil {
+			t.Fatal(err)
+		}
+		values = append(values, v)
+	}
+	expected := []interface{}{"first", []interface{}{[]byte{1, 2, 3}, int16(300)}, nil}
+	if !reflect.DeepEqual(values, expected) {
+		t.Errorf("expected %#v, got %#v", expected, values)
+	}
+
+	// without AliasBytes bin values are copies
+	copied := values[1].([]interface{})[0].([]byte)
+	data[9]++
+	if copied[0] != 1 {
+		t.Error("expected a copy of the bin value")
+	}
+	data[9]--
+
+	v, _, err := UnpackBytesOptions(data[6:], DecoderOptions{AliasBytes: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	aliased := v.([]interface{})[0].([]byte)
+	data[11] = 9
+	if aliased[0] != 1 || aliased[2] != 9 {
+		t.Errorf("expected the bin value to share the input, got %v", aliased)
+	}
+	if cap(aliased) != 3 {
+		t.Errorf("expected appends to the bin value not to overwrite the input, cap %d", cap(aliased))
+	}
+
+	_, rest, err = UnpackBytes(data[6:9])
+	if err != io.ErrUnexpectedEOF || len(rest) != 3 {
+		t.Errorf("expected io.ErrUnexpectedEOF and the input back, got %v % x", err, rest)
+	}
+	_, _, err = UnpackBytes(nil)
+	if err != io.EOF {
+		t.Errorf("expected io.EOF, got %v", err)
+	}
+}
+
+func BenchmarkUnpackBytes(b *testing.B) {
+	buf := new(bytes.Buffer)
+	Pack(buf, []interface{}{"method", []byte("a payload of some size"), []interface{}{1, 2, 3}})
+	data := buf.Bytes()
+	options := DecoderOptions{AliasBytes: true}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		UnpackBytesOptions(data, options)
+	}
+}
+
+func TestEncodedSize(t *testing.T) {
+	var values []interface{}
+	for _, n := range []int64{0, 1, 31, 32, 127, 128, 255, 256, 32767, 32768, 65535, 65536, 1<<31 - 1, 1 << 31, 1<<32 - 1, 1 << 32, 1<<63 - 1} {
+		values = append(values, n, -n, int8(n), int16(n), int32(n), int(n), uint8(n), uint16(n), uint32(n), uint64(n), uint(n))
+	}
+	for _, length := range []int{0, 1, 15, 16, 31, 32, 255, 256, 65535, 65536} {
+		values = append(values,
+			string(make([]byte, length)),
+			make([]byte, length),
+			make([]interface{}, length),
+			make([]int64, length),
+			Ext{Type: 3, Data: make([]byte, length)},
+		)
+	}
+	m := make(map[string]int)
+	for i := 0; i < 20; i++ {
+		m[fmt.Sprint(i)] = i * 1000
+	}
+	values = append(values, nil, true, float32(1.5), 2.5, m, [3]int{-1, 200, 70000},
+		testStruct{testInner: testInner{"60606"}, Name: "x", Age: 7, Next: &testStruct{Tags: []string{"t"}}},
+		&testPoint{1, 2}, time.Unix(1700000000, 5), RawMessage{0x92, 0x01, 0x02},
+		map[interface{}]interface{}{1: []string{"a"}, "b": nil})
+
+	writers := []*PackWriter{NewPackWriter(nil), NewPackWriter(nil), NewPackWriter(nil)}
+	writers[1].Compat = true
+	writers[2].Canonical = true
+	for _, pw := range writers {
+		for _, v := range values {
+			b := new(bytes.Buffer)
+			w := NewPackWriter(b)
+			w.Compat, w.Canonical = pw.Compat, pw.Canonical
+			n, err := w.Encode(v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			size, err := pw.EncodedSize(v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if size != n || size != b.Len() {
+				t.Errorf("%T %.40v (compat %v, canonical %v): EncodedSize %d, packed %d", v, v, pw.Compat, pw.Canonical, size, n)
+			}
+		}
+	}
+
+	_, err := EncodedSize(make(chan int))
+	if _, ok := err.(*UnsupportedTypeError); !ok {
+		t.Errorf("expected an *UnsupportedTypeError, got %v", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	b := new(bytes.Buffer)
+	Pack(b, map[string]interface{}{"a": []interface{}{1, "two", []byte{3}, 4.5, nil}, "t": time.Unix(5, 0)})
+	valid := b.Bytes()
+	if err := Validate(bytes.NewReader(valid)); err != nil {
+		t.Errorf("expected valid data, got %v", err)
+	}
+	if !ValidBytes(valid) {
+		t.Error("expected ValidBytes to be true")
+	}
+
+	deep := bytes.Repeat([]byte{0x91}, 200)
+	deep = append(deep, 0x01)
+
+	cases := []struct {
+		data   []byte
+		offset int
+		err    error
+	}{
+		{[]byte{}, 0, io.ErrUnexpectedEOF},
+		{[]byte{0x92, 0x01, 0xc1}, 2, nil},
+		{[]byte{0x92, 0x01, 0xa5, 'a', 'b'}, 2, io.ErrUnexpectedEOF},
+		{[]byte{0xdc, 0xff, 0xff, 0x01}, 4, io.ErrUnexpectedEOF},
+		{[]byte{0xcd, 0x01}, 0, io.ErrUnexpectedEOF},
+		{[]byte{0x01, 0x02}, 1, ErrTrailingData},
+		{deep, 100, nil},
+		{[]byte{0x92, 0x01, 0xd5, 0xff, 0x00, 0x00}, 2, nil},
+	}
+	for _, c := range cases {
+		for _, r := range []io.Reader{bytes.NewReader(c.data), iotest.OneByteReader(bytes.NewReader(c.data))} {
+			err := Validate(r)
+			e, ok := err.(*ValidationError)
+			if !ok {
+				t.Errorf("% x: expected a *ValidationError, got %v", c.data, err)
+				continue
+			}
+			if e.Offset != c.offset || (c.err != nil && e.Err != c.err) {
+				t.Errorf("% x: expected offset %d and %v, got %v", c.data, c.offset, c.err, err)
+			}
+		}
+		if ValidBytes(c.data) {
+			t.Errorf("% x: expected ValidBytes to be false", c.data)
+		}
+	}
+
+	// reserved types nothing is registered for are left alone
+	if !ValidBytes([]byte{0xd4, 0xfe, 0x00}) {
+		t.Error("expected an unregistered reserved ext type to be valid")
+	}
+
+	err := Validate(bytes.NewReader([]byte{0xc1}))
+	var prefix *InvalidPrefixError
+	if !errors.As(err, &prefix) || prefix.Prefix != 0xc1 {
+		t.Errorf("expected an *InvalidPrefixError inside, got %v", err)
+	}
+	err = Validate(bytes.NewReader(deep))
+	var limit *LimitError
+	if !errors.As(err, &limit) || limit.Limit != "depth" {
+		t.Errorf("expected a depth *LimitError inside, got %v", err)
+	}
+
+	// read errors after the value are reported the same way
+	failure := errors.New("connection reset")
+	err = Validate(io.MultiReader(bytes.NewReader([]byte{0x01}), iotest.ErrReader(failure)))
+	e, ok := err.(*ValidationError)
+	if !ok || e.Offset != 1 || e.Err != failure {
+		t.Errorf("expected a *ValidationError at 1 wrapping the read error, got %v", err)
+	}
+
+	// a value of exactly MaxBytes is followed by trailing data, not over
+	// the limit
+	err = ValidateOptions(bytes.NewReader([]byte{0x92, 0x01, 0x02, 0x03}), DecoderOptions{MaxBytes: 3})
+	if !errors.Is(err, ErrTrailingData) {
+		t.Errorf("expected ErrTrailingData, got %v", err)
+	}
+	if err.Error() != "mpack: invalid data at offset 3: data after the end of the value" {
+		t.Errorf("unexpected message %q", err)
+	}
+}
+
+// named types miss the fast paths and go through reflection
+type (
+	slowInts     []int
+	slowInt32s   []int32
+	slowUint64s  []uint64
+	slowFloat32s []float32
+	slowFloat64s []float64
+	slowStrings  []string
+	slowBools    []bool
+)
+
+func TestTypedSliceEncoding(t *testing.T) {
+	cases := [][2]interface{}{
+		{[]int{0, -1, 200, -70000, 1 << 40}, slowInts{0, -1, 200, -70000, 1 << 40}},
+		{[]int32{5, -300}, slowInt32s{5, -300}},
+		{[]uint64{0, 1 << 63}, slowUint64s{0, 1 << 63}},
+		{[]float32{1.5, -2}, slowFloat32s{1.5, -2}},
+		{[]float64{2.5}, slowFloat64s{2.5}},
+		{[]string{"a", "", "long enough to need a str8 prefix"}, slowStrings{"a", "", "long enough to need a str8 prefix"}},
+		{[]bool{true, false}, slowBools{true, false}},
+		{map[string]string{"b": "2", "a": "1", "c": ""}, map[interface{}]interface{}{"b": "2", "a": "1", "c": ""}},
+		{map[string]interface{}{"b": []int{1}, "a": nil}, map[interface{}]interface{}{"b": []int{1}, "a": nil}},
+		{map[string]string{"k": "v"}, map[interface{}]interface{}{"k": "v"}},
+	}
+	for _, c := range cases {
+		if !bytes.Equal(packCanonical(t, c[0]), packCanonical(t, c[1])) {
+			t.Errorf("%#v: fast path encoding differs from reflection", c[0])
+		}
+		if reflect.ValueOf(c[0]).Len() > 1 && reflect.TypeOf(c[0]).Kind() == reflect.Map {
+			// only canonical maps have a fixed order
+			continue
+		}
+		fast, slow := new(bytes.Buffer), new(bytes.Buffer)
+		Pack(fast, c[0])
+		Pack(slow, c[1])
+		if !bytes.Equal(fast.Bytes(), slow.Bytes()) {
+			t.Errorf("%#v: fast path encoding differs from reflection", c[0])
+		}
+	}
+}
+
+func TestTypedSliceDecoding(t *testing.T) {
+	b := new(bytes.Buffer)
+	Pack(b, []interface{}{1, uint64(1 << 40), -3, 2.5})
+	data := b.Bytes()
+
+	var ints []int
+	var floats []float64
+	var float32s []float32
+	var uints []uint64
+	Unmarshal(data, &ints)
+	if err := Unmarshal(data, &floats); err != nil {
+		t.Error(err)
+	}
+	Unmarshal(data, &float32s)
+	err := Unmarshal(data, &uints)
+	if !reflect.DeepEqual(ints, []int{1, 1 << 40, -3, 0}) {
+		t.Errorf("got %v", ints)
+	}
+	if !reflect.DeepEqual(floats, []float64{1, 1 << 40, -3, 2.5}) || !reflect.DeepEqual(float32s, []float32{1, 1 << 40, -3, 2.5}) {
+		t.Errorf("got %v and %v", floats, float32s)
+	}
+	if _, ok := err.(*UnmarshalTypeError); !ok || !reflect.DeepEqual(uints, []uint64{1, 1 << 40, 0, 0}) {
+		t.Errorf("expected an *UnmarshalTypeError and the unsigned values, got %v %v", err, uints)
+	}
+
+	b.Reset()
+	Pack(b, []interface{}{"a", []byte("b"), nil, []interface{}{1}, "e"})
+	var strs []string
+	err = Unmarshal(b.Bytes(), &strs)
+	if _, ok := err.(*UnmarshalTypeError); !ok || !reflect.DeepEqual(strs, []string{"a", "b", "", "", "e"}) {
+		t.Errorf("expected an *UnmarshalTypeError and the strings, got %v %q", err, strs)
+	}
+
+	b.Reset()
+	Pack(b, map[string]interface{}{"a": "1", "b": []interface{}{int8(-1)}, "c": nil})
+	var sm map[string]string
+	err = Unmarshal(b.Bytes(), &sm)
+	if _, ok := err.(*UnmarshalTypeError); !ok || sm["a"] != "1" || sm["c"] != "" || len(sm) != 3 {
+		t.Errorf("expected an *UnmarshalTypeError and the strings, got %v %v", err, sm)
+	}
+	var im map[string]interface{}
+	err = Unmarshal(b.Bytes(), &im)
+	expected := map[string]interface{}{"a": "1", "b": []interface{}{int8(-1)}, "c": nil}
+	if err != nil || !reflect.DeepEqual(im, expected) {
+		t.Errorf("expected %v, got %v %v", expected, im, err)
+	}
+}
+
+func BenchmarkPackFloat64s(b *testing.B) {
+	v := make([]float64, 1000)
+	pw := NewPackWriter(io.Discard)
+	for i := 0; i < b.N; i++ {
+		pw.Encode(v)
+	}
+}
+
+func BenchmarkDecodeFloat64s(b *testing.B) {
+	buf := new(bytes.Buffer)
+	Pack(buf, make([]float64, 1000))
+	data := buf.Bytes()
+	var v []float64
+	for i := 0; i < b.N; i++ {
+		Unmarshal(data, &v)
+	}
+}
+
+var a_to_pack = []interface{}{0, 1, 1314136620, 12.0, 12.0}
+
+func BenchmarkPackArray(b *testing.B) {
+	fmt.Printf("!!!benchmark testing!!!\n")
+	for i := 0; i < b.N; i++ {
+		b := new(bytes.Buffer)
+		Pack(b, a_to_pack)
+	}
+}
+
+var big = []int64{1285092000, 21600, 0, 1647, 1285113600, 21600, 0, 4231, 1285135200, 21600, 0, 4232, 1285156800, 21600, 0, 4230, 1285178400, 21600, 0, 1009, 1285200000, 21600, 0, 0, 1285221600, 21600, 0, 0, 1285243200, 21600, 0, 0, 1285264800, 21600, 0, 0, 1285286400, 21600, 0, 0, 1285308000, 21600, 0, 0, 1285329600, 21600, 86, 902, 1285351200, 21600, 4852, 4852, 1285372800, 21600, 8105, 8105, 1285394400, 21600, 4947, 4947, 1285416000, 21600, 4833, 4833, 1285437600, 21600, 4769, 4769, 1285459200, 21600, 4845, 4845, 1285480800, 21600, 5755, 5755, 1285502400, 21600, 4849, 4849, 1285524000, 21600, 4747, 4747, 1285545600, 21600, 4797, 4797, 1285567200, 21600, 4790, 4790, 1285588800, 21600, 3801, 3801, 1285610400, 21600, 2830, 2830, 1285632000, 21600, 4220, 4220, 1285653600, 21600, 4219, 4219, 1285675200, 21600, 4221, 4221, 1285696800, 21600, 4220, 4220, 1285718400, 21600, 4220, 4220, 1285740000, 21600, 4220, 4220, 1285761600, 21600, 4143, 4143, 1285783200, 21600, 3181, 3181, 1285804800, 21600, 4222, 4222, 1285826400, 21600, 4222, 4222, 1285848000, 21600, 2826, 2826, 1285869600, 21600, 0, 0, 1285891200, 21600, 0, 0, 1285912800, 21600, 0, 0, 1285934400, 21600, 0, 0, 1285956000, 21600, 0, 0, 1285977600, 21600, 0, 0, 1285999200, 21600, 0, 0, 1286020800, 21600, 0, 0, 1286042400, 21600, 0, 0, 1286064000, 21600, 0, 0, 1286085600, 21600, 0, 0, 1286107200, 21600, 0, 0, 1286128800, 21600, 0, 0, 1286150400, 21600, 0, 0, 1286172000, 21600, 0, 0, 1286193600, 21600, 0, 0, 1286215200, 21600, 0, 0, 1286236800, 21600, 0, 0, 1286258400, 21600, 0, 0, 1286280000, 21600, 0, 0, 1286301600, 21600, 0, 0, 1286323200, 21600, 0, 0, 1286344800, 21600, 0, 0, 1286366400, 21600, 0, 0, 1286388000, 21600, 0, 0, 1286409600, 21600, 0, 0, 1286431200, 21600, 0, 0, 1286452800, 21600, 0, 0, 1286474400, 21600, 0, 0, 1286496000, 21600, 0, 0, 1286517600, 21600, 0, 0, 1286539200, 21600, 0, 0, 1286560800, 21600, 0, 0, 1286582400, 21600, 0, 0, 1286604000, 21600, 0, 0, 1286625600, 21600, 0, 0, 1286647200, 21600, 0, 0, 1286668800, 21600, 0, 0, 1286690400, 21600, 0, 0, 1286712000, 21600, 0, 0, 1286733600, 21600, 0, 0, 1286755200, 21600, 0, 0, 1286776800, 21600, 0, 0, 1286798400, 21600, 0, 0, 1286820000, 21600, 0, 0, 1286841600, 21600, 0, 0, 1286863200, 21600, 0, 0, 1286884800, 21600, 0, 0, 1286906400, 21600, 0, 0, 1286928000, 21600, 0, 0, 1286949600, 21600, 0, 0, 1286971200, 21600, 0, 0, 1286992800, 21600, 0, 0, 1287014400, 21600, 0, 0, 1287036000, 21600, 0, 0, 1287057600, 21600, 0, 0, 1287079200, 21600, 0, 0, 1287100800, 21600, 0, 0, 1287122400, 21600, 0, 0, 1287144000, 21600, 0, 0, 1287165600, 21600, 0, 0, 1287187200, 21600, 0, 0, 1287208800, 21600, 0, 0, 1287230400, 21600, 0, 0, 1287252000, 21600, 0, 0, 1287273600, 21600, 0, 0, 1287295200, 21600, 0, 0, 1287316800, 21600, 0, 0, 1287338400, 21600, 0, 0, 1287360000, 21600, 0, 0, 1287381600, 21600, 0, 0, 1287403200, 21600, 1505, 1505, 1287424800, 21600, 4184, 4184, 1287446400, 21600, 4188, 4188, 1287468000, 21600, 4189, 4189, 1287489600, 21600, 4186, 4186, 1287511200, 21600, 4188, 4188, 1287532800, 21600, 4187, 4187, 1287554400, 21600, 4187, 4187, 1287576000, 21600, 4187, 4187, 1287597600, 21600, 4189, 4189, 1287619200, 21600, 4189, 4189, 1287640800, 21600, 4189, 4189, 1287662400, 21600, 4188, 4188, 1287684000, 21600, 4185, 4185, 1287705600, 21600, 4187, 4187, 1287727200, 21600, 4187, 4187, 1287748800, 21600, 3995, 3995, 1287770400, 21600, 777, 777, 1287792000, 21600, 0, 0, 1287813600, 21600, 0, 0, 1287835200, 21600, 0, 0, 1287856800, 21600, 0, 0, 1287878400, 21600, 0, 0, 1287900000, 21600, 0, 0, 1287921600, 21600, 0, 0, 1287943200, 21600, 0, 0, 1287964800, 21600, 0, 0, 1287986400, 21600, 0, 0, 1288008000, 21600, 0, 0, 1288029600, 21600, 0, 0, 1288051200, 21600, 0, 0, 1288072800, 21600, 0, 0, 1288094400, 21600, 0, 0, 1288116000, 21600, 0, 0, 1288137600, 21600, 0, 0, 1288159200, 21600, 0, 0, 1288180800, 21600, 0, 0, 1288202400, 21600, 0, 0, 1288224000, 21600, 0, 0, 1288245600, 21600, 0, 0, 1288267200, 21600, 0, 0, 1288288800, 21600, 0, 0, 1288310400, 21600, 0, 0, 1288332000, 21600, 0, 0, 1288353600, 21600, 1052, 1052, 1288375200, 21600, 4656, 4656, 1288396800, 21600, 4676, 4676, 1288418400, 21600, 4709, 4709, 1288440000, 21600, 4729, 4729, 1288461600, 21600, 4674, 4674, 1288483200, 21600, 4704, 4704, 1288504800, 21600, 4689, 4689, 1288526400, 21600, 4720, 4720, 1288548000, 21600, 4713, 4713, 1288569600, 21600, 4737, 4737, 1288591200, 21600, 4714, 4714, 1288612800, 21600, 4736, 4736, 1288634400, 21600, 4709, 4709, 1288656000, 21600, 4716, 4716, 1288677600, 21600, 4724, 4724, 1288699200, 21600, 4735, 4735, 1288720800, 21600, 4714, 4714, 1288742400, 21600, 4697, 4697, 1288764000, 21600, 4679, 4679, 1288785600, 21600, 4703, 4703, 1288807200, 21600, 4696, 4696, 1288828800, 21600, 4683, 4683, 1288850400, 21600, 4690, 4690, 1288872000, 21600, 4805, 4805, 1288893600, 21600, 4695, 4695, 1288915200, 21600, 4697, 4697, 1288936800, 21600, 4704, 4704, 1288958400, 21600, 4677, 4677, 1288980000, 21600, 1964, 1964, 1289001600, 21600, 0, 0, 1289023200, 21600, 0, 0, 1289044800, 21600, 0, 0, 1289066400, 21600, 0, 0, 1289088000, 21600, 0, 0, 1289109600, 21600, 0, 0, 1289131200, 21600, 0, 0, 1289152800, 21600, 0, 0, 1289174400, 21600, 0, 0, 1289196000, 21600, 0, 0, 1289217600, 21600, 0, 0, 1289239200, 21600, 0, 0, 1289260800, 21600, 0, 0, 1289282400, 21600, 0, 0, 1289304000, 21600, 0, 0, 1289325600, 21600, 0, 0, 1289347200, 21600, 0, 0, 1289368800, 21600, 0, 0, 1289390400, 21600, 0, 0, 1289412000, 21600, 0, 0, 1289433600, 21600, 0, 0, 1289455200, 21600, 0, 0, 1289476800, 21600, 0, 0, 1289498400, 21600, 0, 0, 1289520000, 21600, 0, 0, 1289541600, 21600, 0, 0, 1289563200, 21600, 0, 0, 1289584800, 21600, 0, 0, 1289606400, 21600, 0, 0, 1289628000, 21600, 0, 0, 1289649600, 21600, 0, 0, 1289671200, 21600, 0, 0, 1289692800, 21600, 0, 0, 1289714400, 21600, 0, 0, 1289736000, 21600, 0, 0, 1289757600, 21600, 0, 0, 1289779200, 21600, 0, 0, 1289800800, 21600, 0, 0, 1289822400, 21600, 0, 0, 1289844000, 21600, 0, 0, 1289865600, 21600, 0, 0, 1289887200, 21600, 0, 0, 1289908800, 21600, 0, 0, 1289930400, 21600, 0, 0, 1289952000, 21600, 0, 0, 1289973600, 21600, 0, 0, 1289995200, 21600, 0, 0, 1290016800, 21600, 0, 0, 1290038400, 21600, 0, 0, 1290060000, 21600, 0, 0, 1290081600, 21600, 0, 0, 1290103200, 21600, 0, 0, 1290124800, 21600, 0, 0, 1290146400, 21600, 0, 0, 1290168000, 21600, 995, 995, 1290189600, 21600, 4152, 4152, 1290211200, 21600, 4180, 4180, 1290232800, 21600, 4184, 4184, 1290254400, 21600, 4183, 4183, 1290276000, 21600, 4183, 4183, 1290297600, 21600, 4182, 4182, 1290319200, 21600, 4182, 4182, 1290340800, 21600, 4181, 4181, 1290362400, 21600, 4185, 4185, 1290384000, 21600, 4185, 4185, 1290405600, 21600, 4184, 4184, 1290427200, 21600, 4182, 4182, 1290448800, 21600, 2455, 2455, 1293559200, 21600, 3416, 3416, 1293580800, 21600, 4196, 4196, 1293602400, 21600, 4190, 4190, 1293624000, 21600, 3559, 3559, 1293645600, 21600, 0, 0, 1293667200, 21600, 0, 0, 1293688800, 21600, 0, 0, 1293710400, 21600, 0, 0, 1293732000, 21600, 1259, 1259, 1293753600, 21600, 4182, 4182, 1293775200, 21600, 4177, 4177, 1293796800, 21600, 4187, 4187, 1293818400, 21600, 4176, 4176, 1293840000, 21600, 4187, 4187, 1293861600, 21600, 4177, 4177, 1293883200, 21600, 4182, 4182, 1293904800, 21600, 4172, 4172, 1293926400, 21600, 4188, 4188, 1293948000, 21600, 4179, 4179, 1293969600, 21600, 4185, 4185, 1293991200, 21600, 4177, 4177, 1294012800, 21600, 4184, 4184, 1294034400, 21600, 4176, 4176, 1294056000, 21600, 4176, 4176, 1294077600, 21600, 4163, 4163, 1294099200, 21600, 4165, 4165, 1294120800, 21600, 4184, 4184, 1294142400, 21600, 4184, 4184, 1294164000, 21600, 2096, 2096, 1294185600, 21600, 0, 0, 1294207200, 21600, 0, 0, 1294228800, 21600, 0, 0, 1294250400, 21600, 0, 0, 1294272000, 21600, 0, 0, 1294293600, 21600, 0, 0, 1294315200, 21600, 0, 0, 1294336800, 21600, 0, 0, 1294358400, 21600, 0, 0, 1294380000, 21600, 0, 0, 1294401600, 21600, 1195, 1195, 1294423200, 21600, 4186, 4186, 1294444800, 21600, 4192, 4192, 1294466400, 21600, 4191, 4191, 1294488000, 21600, 4191, 4191, 1294509600, 21600, 4191, 4191, 1294531200, 21600, 4189, 4189, 1294552800, 21600, 4190, 4190, 1294574400, 21600, 4188, 4188, 1294596000, 21600, 4190, 4190, 1294617600, 21600, 4189, 4189, 1294639200, 21600, 4187, 4187, 1294660800, 21600, 4191, 4191, 1294682400, 21600, 4172, 4172, 1294704000, 21600, 4189, 4189, 1294725600, 21600, 4191, 4191, 1294747200, 21600, 4190, 4190, 1294768800, 21600, 3094, 3094, 1297166400, 21600, 32, 32, 1297188000, 21600, 0, 0, 1297209600, 21600, 0, 0, 1297231200, 21600, 0, 0, 1297252800, 21600, 0, 0, 1297274400, 21600, 0, 0, 1297296000, 21600, 0, 0, 1297317600, 21600, 0, 0, 1297339200, 21600, 0, 0, 1297360800, 21600, 1492, 1492, 1297382400, 21600, 3304, 3304, 1297404000, 21600, 0, 0, 1299499200, 21600, 0, 0, 1299520800, 21600, 411, 411, 1299542400, 21600, 0, 0, 1299564000, 21600, 0, 0, 1299585600, 21600, 0, 0, 1299607200, 21600, 0, 0, 1299628800, 21600, 0, 0, 1299650400, 21600, 0, 0, 1299672000, 21600, 0, 0, 1299693600, 21600, 0, 0, 1299715200, 21600, 0, 0, 1299736800, 21600, 0, 0, 1299758400, 21600, 0, 0, 1299780000, 21600, 0, 0, 1299801600, 21600, 0, 0, 1299823200, 21600, 0, 0, 1299844800, 21600, 0, 0, 1299866400, 21600, 0, 0, 1299888000, 21600, 0, 0, 1299909600, 21600, 0, 0, 1299931200, 21600, 0, 0, 1299952800, 21600, 0, 0, 1299974400, 21600, 0, 0, 1299996000, 21600, 0, 0, 1300017600, 21600, 0, 0, 1300039200, 21600, 0, 0, 1300060800, 21600, 0, 0, 1300082400, 21600, 0, 0, 1300104000, 21600, 0, 0, 1300125600, 21600, 0, 0, 1300147200, 21600, 0, 0, 1300168800, 21600, 0, 0, 1300190400, 21600, 5, 5, 1300212000, 21600, 0, 0, 1300233600, 21600, 0, 0, 1300255200, 21600, 0, 0, 1300276800, 21600, 0, 0, 1300298400, 21600, 0, 0, 1300320000, 21600, 0, 0, 1300341600, 21600, 0, 0, 1300363200, 21600, 1, 1, 1300384800, 21600, 0, 0, 1300406400, 21600, 0, 0, 1300428000, 21600, 0, 0, 1300449600, 21600, 0, 0, 1300471200, 21600, 0, 0, 1300492800, 21600, 0, 0, 1300514400, 21600, 0, 0, 1300536000, 21600, 0, 0, 1300557600, 21600, 0, 0, 1300579200, 21600, 0, 0, 1300600800, 21600, 0, 0, 1300622400, 21600, 0, 0, 1300644000, 21600, 0, 0, 1300665600, 21600, 0, 0, 1300687200, 21600, 0, 0, 1300708800, 21600, 0, 0, 1300730400, 21600, 0, 0, 1300752000, 21600, 0, 0, 1300773600, 21600, 0, 0, 1300795200, 21600, 0, 0, 1300816800, 21600, 0, 0, 1300838400, 21600, 0, 0, 1300860000, 21600, 0, 0, 1300881600, 21600, 0, 0, 1300903200, 21600, 0, 0, 1300924800, 21600, 0, 0, 1300946400, 21600, 0, 0, 1300968000, 21600, 0, 0, 1300989600, 21600, 0, 0, 1301011200, 21600, 0, 0, 1301032800, 21600, 0, 0, 1301054400, 21600, 0, 0, 1301076000, 21600, 0, 0, 1301097600, 21600, 0, 0, 1301119200, 21600, 0, 0, 1301140800, 21600, 0, 0, 1301162400, 21600, 0, 0, 1301184000, 21600, 0, 0, 1301205600, 21600, 0, 0, 1301227200, 21600, 0, 0, 1301248800, 21600, 0, 0, 1301270400, 21600, 0, 0, 1301292000, 21600, 0, 0, 1301313600, 21600, 0, 0, 1301335200, 21600, 3578, 3578, 1301356800, 21600, 3740, 3740, 1301378400, 21600, 2366, 2366, 1301400000, 21600, 0, 0, 1301421600, 21600, 0, 0, 1301443200, 21600, 0, 0, 1301464800, 21600, 0, 0, 1301486400, 21600, 0, 0, 1301508000, 21600, 0, 0, 1301529600, 21600, 0, 0, 1301551200, 21600, 0, 0, 1301572800, 21600, 0, 0, 1301594400, 21600, 0, 0, 1301616000, 21600, 0, 0, 1301637600, 21600, 0, 0, 1301659200, 21600, 5, 5, 1301680800, 21600, 0, 0, 1301702400, 21600, 0, 0, 1301724000, 21600, 0, 0, 1301745600, 21600, 0, 0, 1301767200, 21600, 0, 0, 1301788800, 21600, 0, 0, 1301810400, 21600, 0, 0, 1301832000, 21600, 0, 0, 1301853600, 21600, 0, 0, 1301875200, 21600, 0, 0, 1301896800, 21600, 0, 0, 1301918400, 21600, 0, 0, 1301940000, 21600, 0, 0, 1301961600, 21600, 0, 0, 1301983200, 21600, 0, 0, 1302004800, 21600, 0, 0, 1302026400, 21600, 0, 0, 1302048000, 21600, 0, 0, 1302069600, 21600, 0, 0, 1302091200, 21600, 0, 0, 1302112800, 21600, 0, 0, 1302134400, 21600, 0, 0, 1302156000, 21600, 0, 0, 1302177600, 21600, 0, 0, 1302199200, 21600, 0, 0, 1302220800, 21600, 0, 0, 1302242400, 21600, 0, 0, 1302264000, 21600, 0, 0, 1302285600, 21600, 0, 0, 1302307200, 21600, 0, 0, 1302328800, 21600, 0, 0, 1302350400, 21600, 0, 0, 1302372000, 21600, 0, 0, 1302393600, 21600, 0, 0, 1302415200, 21600, 0, 0, 1302436800, 21600, 0, 0, 1302458400, 21600, 0, 0, 1302480000, 21600, 0, 0, 1302501600, 21600, 0, 0, 1302523200, 21600, 0, 0, 1302544800, 21600, 0, 0, 1302566400, 21600, 0, 0, 1302588000, 21600, 0, 0, 1302609600, 21600, 0, 0, 1302631200, 21600, 0, 0, 1302652800, 21600, 0, 0, 1302674400, 21600, 0, 0, 1302696000, 21600, 0, 0, 1302717600, 21600, 0, 0, 1302739200, 21600, 0, 0, 1302760800, 21600, 0, 0, 1302782400, 21600, 0, 0, 1302804000, 21600, 0, 0, 1302825600, 21600, 0, 0, 1302847200, 21600, 0, 0, 1302868800, 21600, 0, 0, 1302890400, 21600, 0, 0, 1302912000, 21600, 0, 0, 1302933600, 21600, 0, 0, 1302955200, 21600, 0, 0, 1302976800, 21600, 0, 0, 1302998400, 21600, 0, 0, 1303020000, 21600, 0, 0, 1303041600, 21600, 0, 0, 1303063200, 21600, 0, 0, 1303084800, 21600, 0, 0, 1303106400, 21600, 0, 0, 1303128000, 21600, 0, 0, 1303149600, 21600, 0, 0, 1303171200, 21600, 0, 0, 1303192800, 21600, 0, 0, 1303214400, 21600, 0, 0, 1303236000, 21600, 0, 0, 1303257600, 21600, 0, 0, 1303279200, 21600, 0, 0, 1303300800, 21600, 0, 0, 1303322400, 21600, 0, 0, 1303344000, 21600, 0, 0, 1303365600, 21600, 0, 0, 1303387200, 21600, 0, 0, 1303408800, 21600, 0, 0, 1303430400, 21600, 0, 0, 1303452000, 21600, 0, 0, 1303473600, 21600, 0, 0, 1303495200, 21600, 0, 0, 1303516800, 21600, 0, 0, 1303538400, 21600, 0, 0, 1303560000, 21600, 0, 0, 1303581600, 21600, 0, 0, 1303603200, 21600, 0, 0, 1303624800, 21600, 0, 0, 1303646400, 21600, 0, 0, 1303668000, 21600, 0, 0, 1303689600, 21600, 0, 0, 1303711200, 21600, 0, 0, 1303732800, 21600, 0, 0, 1303754400, 21600, 0, 0, 1303776000, 21600, 0, 0, 1303797600, 21600, 0, 0, 1303819200, 21600, 0, 0, 1303840800, 21600, 0, 0, 1303862400, 21600, 0, 0, 1303884000, 21600, 0, 0, 1303905600, 21600, 0, 0, 1303927200, 21600, 0, 0, 1303948800, 21600, 0, 0, 1303970400, 21600, 0, 0, 1303992000, 21600, 0, 0, 1304013600, 21600, 0, 0, 1304035200, 21600, 0, 0, 1304056800, 21600, 0, 0, 1304078400, 21600, 0, 0, 1304100000, 21600, 0, 0, 1304121600, 21600, 0, 0, 1304143200, 21600, 0, 0, 1304164800, 21600, 0, 0, 1304186400, 21600, 0, 0, 1304208000, 21600, 0, 0, 1304229600, 21600, 0, 0, 1304251200, 21600, 0, 0, 1304272800, 21600, 0, 0, 1304294400, 21600, 0, 0, 1304316000, 21600, 0, 0, 1304337600, 21600, 0, 0, 1304359200, 21600, 0, 0, 1304380800, 21600, 0, 0, 1304402400, 21600, 0, 0, 1304424000, 21600, 0, 0, 1304445600, 21600, 0, 0, 1304467200, 21600, 0, 0, 1304488800, 21600, 0, 0, 1304510400, 21600, 0, 0, 1304532000, 21600, 0, 0, 1304553600, 21600, 0, 0, 1304575200, 21600, 0, 0, 1304596800, 21600, 0, 0, 1304618400, 21600, 0, 0, 1304640000, 21600, 0, 0, 1304661600, 21600, 0, 0, 1304683200, 21600, 0, 0, 1304704800, 21600, 0, 0, 1304726400, 21600, 0, 0, 1304748000, 21600, 0, 0, 1304769600, 21600, 0, 0, 1304791200, 21600, 0, 0, 1304812800, 21600, 0, 0, 1304834400, 21600, 0, 0, 1304856000, 21600, 0, 0, 1304877600, 21600, 0, 0, 1304899200, 21600, 0, 0, 1304920800, 21600, 0, 0, 1304942400, 21600, 0, 0, 1304964000, 21600, 0, 0, 1304985600, 21600, 0, 0, 1305007200, 21600, 0, 0, 1305028800, 21600, 0, 0, 1305050400, 21600, 0, 0, 1305072000, 21600, 0, 0, 1305093600, 21600, 0, 0, 1305115200, 21600, 0, 0, 1305136800, 21600, 0, 0, 1305158400, 21600, 0, 0, 1305180000, 21600, 0, 0, 1305201600, 21600, 0, 0, 1305223200, 21600, 0, 0, 1305244800, 21600, 0, 0, 1305266400, 21600, 0, 0, 1305288000, 21600, 0, 0, 1305309600, 21600, 0, 0, 1305331200, 21600, 0, 0, 1305352800, 21600, 0, 0, 1305374400, 21600, 0, 0, 1305396000, 21600, 0, 0, 1305417600, 21600, 0, 0, 1305439200, 21600, 0, 0, 1305460800, 21600, 0, 0, 1305482400, 21600, 0, 0, 1305504000, 21600, 0, 0, 1305525600, 21600, 0, 0, 1305547200, 21600, 0, 0, 1305568800, 21600, 0, 0, 1305590400, 21600, 0, 0, 1305612000, 21600, 0, 0, 1305633600, 21600, 0, 0, 1305655200, 21600, 0, 0, 1305676800, 21600, 0, 0, 1305698400, 21600, 0, 0, 1305720000, 21600, 0, 0, 1305741600, 21600, 0, 0, 1305763200, 21600, 0, 0, 1305784800, 21600, 0, 0, 1305806400, 21600, 0, 0, 1305828000, 21600, 0, 0, 1305849600, 21600, 0, 0, 1305871200, 21600, 0, 0, 1305892800, 21600, 0, 0, 1305914400, 21600, 0, 0, 1305936000, 21600, 0, 0, 1305957600, 21600, 0, 0, 1305979200, 21600, 0, 0, 1306000800, 21600, 0, 0, 1306022400, 21600, 0, 0, 1306044000, 21600, 0, 0, 1306065600, 21600, 0, 0, 1306087200, 21600, 0, 0, 1306108800, 21600, 0, 0, 1306130400, 21600, 0, 0, 1306152000, 21600, 0, 0, 1306173600, 21600, 0, 0, 1306195200, 21600, 0, 0, 1306216800, 21600, 0, 0, 1306238400, 21600, 0, 0, 1306260000, 21600, 0, 0, 1306281600, 21600, 0, 0, 1306303200, 21600, 0, 0, 1306324800, 21600, 0, 0, 1306346400, 21600, 0, 0, 1306368000, 21600, 0, 0, 1306389600, 21600, 0, 0, 1306411200, 21600, 0, 0, 1306432800, 21600, 0, 0, 1306454400, 21600, 0, 0, 1306476000, 21600, 0, 0, 1306497600, 21600, 0, 0, 1306519200, 21600, 0, 0, 1306540800, 21600, 0, 0, 1306562400, 21600, 0, 0, 1306584000, 21600, 0, 0, 1306605600, 21600, 0, 0, 1306627200, 21600, 0, 0, 1306648800, 21600, 0, 0, 1306670400, 21600, 0, 0, 1306692000, 21600, 0, 0, 1306713600, 21600, 0, 0, 1306735200, 21600, 0, 0, 1306756800, 21600, 0, 0, 1306778400, 21600, 0, 0, 1306800000, 21600, 0, 0, 1306821600, 21600, 0, 0, 1306843200, 21600, 0, 0, 1306864800, 21600, 0, 0, 1306886400, 21600, 0, 0, 1306908000, 21600, 0, 0, 1306929600, 21600, 0, 0, 1306951200, 21600, 1675, 1675, 1306972800, 21600, 4317, 4317, 1306994400, 21600, 4317, 4317, 1307016000, 21600, 4317, 4317, 1307037600, 21600, 4317, 4317, 1307059200, 21600, 4317, 4317, 1307080800, 21600, 4317, 4317, 1307102400, 21600, 4317, 4317, 1307124000, 21600, 4317, 4317, 1307145600, 21600, 4317, 4317, 1307167200, 21600, 4317, 4317, 1307188800, 21600, 4317, 4317, 1307210400, 21600, 4317, 4317, 1307232000, 21600, 4317, 4317, 1307253600, 21600, 4317, 4317, 1307275200, 21600, 4317, 4317, 1307296800, 21600, 4317, 4317, 1307318400, 21600, 4317, 4317, 1307340000, 21600, 4317, 4317, 1307361600, 21600, 4317, 4317, 1307383200, 21600, 3898, 3898, 1307404800, 21600, 0, 0, 1307426400, 21600, 0, 0, 1307448000, 21600, 2436, 2436, 1307469600, 21600, 4317, 4317, 1307491200, 21600, 4317, 4317, 1307512800, 21600, 0, 0, 1307534400, 21600, 4317, 4317, 1307556000, 21600, 4317, 4317, 1307577600, 21600, 4317, 4317, 1307599200, 21600, 4317, 4317, 1307620800, 21600, 4317, 4317, 1307642400, 21600, 4317, 4317, 1307664000, 21600, 4317, 4317, 1307685600, 21600, 4317, 4317, 1307707200, 21600, 4317, 4317, 1307728800, 21600, 4317, 4317, 1307750400, 21600, 4317, 4317, 1307772000, 21600, 4317, 4317, 1307793600, 21600, 4317, 4317, 1307815200, 21600, 4317, 4317, 1307836800, 21600, 4317, 4317, 1307858400, 21600, 4317, 4317, 1307880000, 21600, 4317, 4317, 1307901600, 21600, 4317, 4317, 1307923200, 21600, 4317, 4317, 1307944800, 21600, 4317, 4317, 1307966400, 21600, 4317, 4317, 1307988000, 21600, 4317, 4317, 1308009600, 21600, 4317, 4317, 1308031200, 21600, 4316, 4316, 1308052800, 21600, 4317, 4317, 1308074400, 21600, 4317, 4317, 1308096000, 21600, 4317, 4317, 1308117600, 21600, 4317, 4317, 1308139200, 21600, 4317, 4317, 1308160800, 21600, 4317, 4317, 1308182400, 21600, 4317, 4317, 1308204000, 21600, 4317, 4317, 1308225600, 21600, 4317, 4317, 1308247200, 21600, 4316, 4316, 1308268800, 21600, 4317, 4317, 1308290400, 21600, 4317, 4317, 1308312000, 21600, 4317, 4317, 1308333600, 21600, 4317, 4317, 1308355200, 21600, 4317, 4317, 1308376800, 21600, 4317, 4317, 1308398400, 21600, 4317, 4317, 1308420000, 21600, 4317, 4317, 1308441600, 21600, 4317, 4317, 1308463200, 21600, 4316, 4316, 1308484800, 21600, 4317, 4317, 1308506400, 21600, 4317, 4317, 1308528000, 21600, 4317, 4317, 1308549600, 21600, 4317, 4317, 1308571200, 21600, 4317, 4317, 1308592800, 21600, 4317, 4317, 1308614400, 21600, 4317, 4317, 1308636000, 21600, 0, 0, 1308657600, 21600, 2338, 2338, 1313096400, 900, 0, 0, 1313097300, 900, 11, 11, 1313098200, 900, 192, 192, 1313099100, 900, 179, 179, 1313100000, 900, 180, 180, 1313100900, 900, 180, 180, 1313101800, 900, 180, 180, 1313102700, 900, 180, 180, 1313103600, 900, 180, 180, 1313104500, 900, 179, 179, 1313105400, 900, 180, 180, 1313106300, 900, 180, 180, 1313107200, 900, 180, 180, 1313108100, 900, 180, 180, 1313109000, 900, 179, 179, 1313109900, 900, 180, 180, 1313110800, 900, 180, 180, 1313111700, 900, 180, 180, 1313112600, 900, 180, 180, 1313113500, 900, 180, 180, 1313114400, 900, 179, 179, 1313115300, 900, 180, 180, 1313116200, 900, 180, 180, 1313117100, 900, 180, 180, 1313118000, 900, 180, 180, 1313118900, 900, 180, 180, 1313119800, 900, 179, 179, 1313120700, 900, 180, 180, 1313121600, 900, 180, 180, 1313122500, 900, 180, 180, 1313123400, 900, 180, 180, 1313124300, 900, 179, 179, 1313125200, 900, 180, 180, 1313126100, 900, 180, 180, 1313127000, 900, 180, 180, 1313127900, 900, 180, 180, 1313128800, 900, 180, 180, 1313129700, 900, 179, 179, 1313130600, 900, 180, 180, 1313131500, 900, 180, 180, 1313132400, 900, 180, 180, 1313133300, 900, 180, 180, 1313134200, 900, 179, 179, 1313135100, 900, 180, 180, 1313136000, 900, 180, 180, 1313136900, 900, 180, 180, 1313137800, 900, 180, 180, 1313138700, 900, 180, 180, 1313139600, 900, 179, 179, 1313140500, 900, 180, 180, 1313141400, 900, 180, 180, 1313142300, 900, 180, 180, 1313143200, 900, 180, 180, 1313144100, 900, 180, 180, 1313145000, 900, 179, 179, 1313145900, 900, 180, 180, 1313146800, 900, 180, 180, 1313147700, 900, 180, 180, 1313148600, 900, 180, 180, 1313149500, 900, 180, 180, 1313150400, 900, 179, 179, 1313151300, 900, 180, 180, 1313152200, 900, 180, 180, 1313153100, 900, 180, 180, 1313154000, 900, 180, 180, 1313154900, 900, 179, 179, 1313155800, 900, 180, 180, 1313156700, 900, 180, 180, 1313157600, 900, 180, 180, 1313158500, 900, 180, 180, 1313159400, 900, 180, 180, 1313160300, 900, 179, 179, 1313161200, 900, 180, 180, 1313162100, 900, 180, 180, 1313163000, 900, 180, 180, 1313163900, 900, 180, 180, 1313164800, 900, 180, 180, 1313165700, 900, 179, 179, 1313166600, 900, 180, 180, 1313167500, 900, 180, 180, 1313168400, 900, 180, 180, 1313169300, 900, 180, 180, 1313170200, 900, 179, 179, 1313171100, 900, 180, 180, 1313172000, 900, 180, 180, 1313172900, 900, 180, 180, 1313173800, 900, 180, 180, 1313174700, 900, 179, 179, 1313175600, 900, 180, 180, 1313176500, 900, 180, 180, 1313177400, 900, 180, 180, 1313178300, 900, 180, 180, 1313179200, 900, 180, 180, 1313180100, 900, 179, 179, 1313181000, 900, 180, 180, 1313181900, 900, 180, 180, 1313182800, 900, 180, 180, 1313183700, 900, 180, 180, 1313184600, 900, 180, 180, 1313185500, 900, 179, 179, 1313186400, 900, 180, 180, 1313187300, 900, 180, 180, 1313188200, 900, 180, 180, 1313189100, 900, 180, 180, 1313190000, 900, 179, 179, 1313190900, 900, 180, 180, 1313191800, 900, 180, 180, 1313192700, 900, 180, 180, 1313193600, 900, 180, 180, 1313194500, 900, 180, 180, 1313195400, 900, 179, 179, 1313196300, 900, 180, 180, 1313197200, 900, 180, 180, 1313198100, 900, 180, 180, 1313199000, 900, 180, 180, 1313199900, 900, 180, 180, 1313200800, 900, 179, 179, 1313201700, 900, 180, 180, 1313202600, 900, 180, 180, 1313203500, 900, 180, 180, 1313204400, 900, 180, 180, 1313205300, 900, 179, 179, 1313206200, 900, 180, 180, 1313207100, 900, 180, 180, 1313208000, 900, 180, 180, 1313208900, 900, 180, 180, 1313209800, 900, 180, 180, 1313210700, 900, 179, 179, 1313211600, 900, 180, 180, 1313212500, 900, 180, 180, 1313213400, 900, 180, 180, 1313214300, 900, 180, 180, 1313215200, 900, 180, 180, 1313216100, 900, 179, 179, 1313217000, 900, 180, 180, 1313217900, 900, 180, 180, 1313218800, 900, 180, 180, 1313219700, 900, 180, 180, 1313220600, 900, 180, 180, 1313221500, 900, 179, 179, 1313222400, 900, 180, 180, 1313223300, 900, 180, 180, 1313224200, 900, 180, 180, 1313225100, 900, 180, 180, 1313226000, 900, 179, 179, 1313226900, 900, 180, 180, 1313227800, 900, 180, 180, 1313228700, 900, 180, 180, 1313229600, 900, 180, 180, 1313230500, 900, 180, 180, 1313231400, 900, 179, 179, 1313232300, 900, 180, 180, 1313233200, 900, 180, 180, 1313234100, 900, 180, 180, 1313235000, 900, 180, 180, 1313235900, 900, 180, 180, 1313236800, 900, 179, 179, 1313237700, 900, 180, 180, 1313238600, 900, 180, 180, 1313239500, 900, 180, 180, 1313240400, 900, 180, 180, 1313241300, 900, 180, 180, 1313242200, 900, 179, 179, 1313243100, 900, 180, 180, 1313244000, 900, 180, 180, 1313244900, 900, 180, 180, 1313245800, 900, 180, 180, 1313246700, 900, 179, 179, 1313247600, 900, 180, 180, 1313248500, 900, 180, 180, 1313249400, 900, 180, 180, 1313250300, 900, 180, 180, 1313251200, 900, 180, 180, 1313252100, 900, 179, 179, 1313253000, 900, 180, 180, 1313253900, 900, 180, 180, 1313254800, 900, 180, 180, 1313255700, 900, 180, 180, 1313256600, 900, 180, 180, 1313257500, 900, 179, 179, 1313258400, 900, 180, 180, 1313259300, 900, 180, 180, 1313260200, 900, 180, 180, 1313261100, 900, 180, 180, 1313262000, 900, 179, 179, 1313262900, 900, 180, 180, 1313263800, 900, 180, 180, 1313264700, 900, 180, 180, 1313265600, 900, 180, 180, 1313266500, 900, 180, 180, 1313267400, 900, 179, 179, 1313268300, 900, 180, 180, 1313269200, 900, 180, 180, 1313270100, 900, 180, 180, 1313271000, 900, 180, 180, 1313271900, 900, 180, 180, 1313272800, 900, 179, 179, 1313273700, 900, 180, 180, 1313274600, 900, 180, 180, 1313275500, 900, 180, 180, 1313276400, 900, 180, 180, 1313277300, 900, 180, 180, 1313278200, 900, 179, 179, 1313279100, 900, 180, 180, 1313280000, 900, 180, 180, 1313280900, 900, 180, 180, 1313281800, 900, 180, 180, 1313282700, 900, 179, 179, 1313283600, 900, 180, 180, 1313284500, 900, 180, 180, 1313285400, 900, 180, 180, 1313286300, 900, 180, 180, 1313287200, 900, 180, 180, 1313288100, 900, 179, 179, 1313289000, 900, 180, 180, 1313289900, 900, 180, 180, 1313290800, 900, 180, 180, 1313291700, 900, 180, 180, 1313292600, 900, 179, 179, 1313293500, 900, 180, 180, 1313294400, 900, 180, 180, 1313295300, 900, 180, 180, 1313296200, 900, 180, 180, 1313297100, 900, 180, 180, 1313298000, 900, 179, 179, 1313298900, 900, 180, 180, 1313299800, 900, 180, 180, 1313300700, 900, 180, 180, 1313301600, 900, 180, 180, 1313302500, 900, 180, 180, 1313303400, 900, 179, 179, 1313304300, 900, 180, 180, 1313305200, 900, 180, 180, 1313306100, 900, 180, 180, 1313307000, 900, 180, 180, 1313307900, 900, 179, 179, 1313308800, 900, 180, 180, 1313309700, 900, 180, 180, 1313310600, 900, 180, 180, 1313311500, 900, 180, 180, 1313312400, 900, 180, 180, 1313313300, 900, 179, 179, 1313314200, 900, 180, 180, 1313315100, 900, 180, 180, 1313316000, 900, 180, 180, 1313316900, 900, 180, 180, 1313317800, 900, 179, 179, 1313318700, 900, 180, 180, 1313319600, 900, 180, 180, 1313320500, 900, 180, 180, 1313321400, 900, 180, 180, 1313322300, 900, 180, 180, 1313323200, 900, 179, 179, 1313324100, 900, 180, 180, 1313325000, 900, 180, 180, 1313325900, 900, 180, 180, 1313326800, 900, 180, 180, 1313327700, 900, 180, 180, 1313328600, 900, 179, 179, 1313329500, 900, 180, 180, 1313330400, 900, 180, 180, 1313331300, 900, 180, 180, 1313332200, 900, 180, 180, 1313333100, 900, 180, 180, 1313334000, 900, 179, 179, 1313334900, 900, 180, 180, 1313335800, 900, 0, 0, 1313336700, 900, 180, 180, 1313337600, 900, 180, 180, 1313338500, 900, 179, 179, 1313339400, 900, 0, 0, 1313340300, 900, 180, 180, 1313341200, 900, 180, 180, 1313342100, 900, 180, 180, 1313343000, 900, 0, 0, 1313343900, 900, 180, 180, 1313344800, 900, 180, 180, 1313345700, 900, 180, 180, 1313346600, 900, 180, 180, 1313347500, 900, 179, 179, 1313348400, 900, 180, 180, 1313349300, 900, 180, 180, 1313350200, 900, 180, 180, 1313351100, 900, 180, 180, 1313352000, 900, 180, 180, 1313352900, 900, 179, 179, 1313353800, 900, 180, 180, 1313354700, 900, 180, 180, 1313355600, 900, 180, 180, 1313356500, 900, 180, 180, 1313357400, 900, 179, 179, 1313358300, 900, 180, 180, 1313359200, 900, 180, 180, 1313360100, 900, 180, 180, 1313361000, 900, 180, 180, 1313361900, 900, 180, 180, 1313362800, 900, 179, 179, 1313363700, 900, 180, 180, 1313364600, 900, 180, 180, 1313365500, 900, 180, 180, 1313366400, 900, 180, 180, 1313367300, 900, 180, 180, 1313368200, 900, 179, 179, 1313369100, 900, 180, 180, 1313370000, 900, 180, 180, 1313370900, 900, 180, 180, 1313371800, 900, 180, 180, 1313372700, 900, 180, 180, 1313373600, 900, 179, 179, 1313374500, 900, 180, 180, 1313375400, 900, 180, 180, 1313376300, 900, 180, 180, 1313377200, 900, 180, 180, 1313378100, 900, 179, 179, 1313379000, 900, 180, 180, 1313379900, 900, 180, 180, 1313380800, 900, 180, 180, 1313381700, 900, 180, 180, 1313382600, 900, 180, 180, 1313383500, 900, 179, 179, 1313384400, 900, 180, 180, 1313385300, 900, 180, 180, 1313386200, 900, 180, 180, 1313387100, 900, 180, 180, 1313388000, 900, 180, 180, 1313388900, 900, 179, 179, 1313389800, 900, 180, 180, 1313390700, 900, 180, 180, 1313391600, 900, 180, 180, 1313392500, 900, 180, 180, 1313393400, 900, 180, 180, 1313394300, 900, 179, 179, 1313395200, 900, 180, 180, 1313396100, 900, 180, 180, 1313397000, 900, 180, 180, 1313397900, 900, 180, 180, 1313398800, 900, 179, 179, 1313399700, 900, 180, 180, 1313400600, 900, 180, 180, 1313401500, 900, 180, 180, 1313402400, 900, 180, 180, 1313403300, 900, 180, 180, 1313404200, 900, 179, 179, 1313405100, 900, 180, 180, 1313406000, 900, 180, 180, 1313406900, 900, 180, 180, 1313407800, 900, 180, 180, 1313408700, 900, 180, 180, 1313409600, 900, 179, 179, 1313410500, 900, 180, 180, 1313411400, 900, 180, 180, 1313412300, 900, 180, 180, 1313413200, 900, 180, 180, 1313414100, 900, 180, 180, 1313415000, 900, 179, 179, 1313415900, 900, 180, 180, 1313416800, 900, 180, 180, 1313417700, 900, 180, 180, 1313418600, 900, 179, 179, 1313419500, 900, 180, 180, 1313420400, 900, 180, 180, 1313421300, 900, 180, 180, 1313422200, 900, 180, 180, 1313423100, 900, 179, 179, 1313424000, 900, 180, 180, 1313424900, 900, 180, 180, 1313425800, 900, 180, 180, 1313426700, 900, 180, 180, 1313427600, 900, 180, 180, 1313428500, 900, 179, 179, 1313429400, 900, 180, 180, 1313430300, 900, 180, 180, 1313431200, 900, 180, 180, 1313432100, 900, 180, 180, 1313433000, 900, 180, 180, 1313433900, 900, 179, 179, 1313434800, 900, 180, 180, 1313435700, 900, 180, 180, 1313436600, 900, 180, 180, 1313437500, 900, 180, 180, 1313438400, 900, 179, 179, 1313439300, 900, 180, 180, 1313440200, 900, 180, 180, 1313441100, 900, 180, 180, 1313442000, 900, 180, 180, 1313442900, 900, 180, 180, 1313443800, 900, 179, 179, 1313444700, 900, 180, 180, 1313445600, 900, 180, 180, 1313446500, 900, 180, 180, 1313447400, 900, 180, 180, 1313448300, 900, 180, 180, 1313449200, 900, 179, 179, 1313450100, 900, 180, 180, 1313451000, 900, 180, 180, 1313451900, 900, 180, 180, 1313452800, 900, 180, 180, 1313453700, 900, 179, 179, 1313454600, 900, 180, 180, 1313455500, 900, 180, 180, 1313456400, 900, 180, 180, 1313457300, 900, 180, 180, 1313458200, 900, 180, 180, 1313459100, 900, 179, 179, 1313460000, 900, 180, 180, 1313460900, 900, 180, 180, 1313461800, 900, 180, 180, 1313462700, 900, 180, 180, 1313463600, 900, 180, 180, 1313464500, 900, 179, 179, 1313465400, 900, 180, 180, 1313466300, 900, 180, 180, 1313467200, 900, 180, 180, 1313468100, 900, 180, 180, 1313469000, 900, 179, 179, 1313469900, 900, 180, 180, 1313470800, 900, 180, 180, 1313471700, 900, 180, 180, 1313472600, 900, 180, 180, 1313473500, 900, 179, 179, 1313474400, 900, 180, 180, 1313475300, 900, 180, 180, 1313476200, 900, 180, 180, 1313477100, 900, 180, 180, 1313478000, 900, 180, 180, 1313478900, 900, 179, 179, 1313479800, 900, 180, 180, 1313480700, 900, 180, 180, 1313481600, 900, 180, 180, 1313482500, 900, 180, 180, 1313483400, 900, 179, 179, 1313484300, 900, 180, 180, 1313485200, 900, 180, 180, 1313486100, 900, 180, 180, 1313487000, 900, 180, 180, 1313487900, 900, 180, 180, 1313488800, 900, 179, 179, 1313489700, 900, 180, 180, 1313490600, 900, 180, 180, 1313491500, 900, 180, 180, 1313492400, 900, 180, 180, 1313493300, 900, 180, 180, 1313494200, 900, 179, 179, 1313495100, 900, 180, 180, 1313496000, 900, 180, 180, 1313496900, 900, 180, 180, 1313497800, 900, 180, 180, 1313498700, 900, 180, 180, 1313499600, 900, 179, 179, 1313500500, 900, 180, 180, 1313501400, 900, 180, 180, 1313502300, 900, 180, 180, 1313503200, 900, 180, 180, 1313504100, 900, 179, 179, 1313505000, 900, 180, 180, 1313505900, 900, 180, 180, 1313506800, 900, 180, 180, 1313507700, 900, 180, 180, 1313508600, 900, 180, 180, 1313509500, 900, 179, 179, 1313510400, 900, 180, 180, 1313511300, 900, 180, 180, 1313512200, 900, 180, 180, 1313513100, 900, 180, 180, 1313514000, 900, 179, 179, 1313514900, 900, 180, 180, 1313515800, 900, 180, 180, 1313516700, 900, 180, 180, 1313517600, 900, 180, 180, 1313518500, 900, 180, 180, 1313519400, 900, 179, 179, 1313520300, 900, 180, 180, 1313521200, 900, 180, 180, 1313522100, 900, 180, 180, 1313523000, 900, 180, 180, 1313523900, 900, 180, 180, 1313524800, 900, 179, 179, 1313525700, 900, 180, 180, 1313526600, 900, 180, 180, 1313527500, 900, 180, 180, 1313528400, 900, 180, 180, 1313529300, 900, 179, 179, 1313530200, 900, 180, 180, 1313531100, 900, 180, 180, 1313532000, 900, 180, 180, 1313532900, 900, 180, 180, 1313533800, 900, 180, 180, 1313534700, 900, 179, 179, 1313535600, 900, 180, 180, 1313536500, 900, 180, 180, 1313537400, 900, 180, 180, 1313538300, 900, 180, 180, 1313539200, 900, 179, 179, 1313540100, 900, 180, 180, 1313541000, 900, 180, 180, 1313541900, 900, 180, 180, 1313542800, 900, 180, 180, 1313543700, 900, 179, 179, 1313544600, 900, 180, 180, 1313545500, 900, 180, 180, 1313546400, 900, 180, 180, 1313547300, 900, 180, 180, 1313548200, 900, 180, 180, 1313549100, 900, 179, 179, 1313550000, 900, 180, 180, 1313550900, 900, 180, 180, 1313551800, 900, 180, 180, 1313552700, 900, 180, 180, 1313553600, 900, 179, 179, 1313554500, 900, 180, 180, 1313555400, 900, 180, 180, 1313556300, 900, 180, 180, 1313557200, 900, 180, 180, 1313558100, 900, 180, 180, 1313559000, 900, 179, 179, 1313559900, 900, 180, 180, 1313560800, 900, 180, 180, 1313561700, 900, 180, 180, 1313562600, 900, 180, 180, 1313563500, 900, 180, 180, 1313564400, 900, 179, 179, 1313565300, 900, 180, 180, 1313566200, 900, 180, 180, 1313567100, 900, 180, 180, 1313568000, 900, 180, 180, 1313568900, 900, 179, 179, 1313569800, 900, 180, 180, 1313570700, 900, 180, 180, 1313571600, 900, 180, 180, 1313572500, 900, 180, 180, 1313573400, 900, 180, 180, 1313574300, 900, 179, 179, 1313575200, 900, 180, 180, 1313576100, 900, 180, 180, 1313577000, 900, 180, 180, 1313577900, 900, 180, 180, 1313578800, 900, 180, 180, 1313579700, 900, 179, 179, 1313580600, 900, 180, 180, 1313581500, 900, 180, 180, 1313582400, 900, 180, 180, 1313583300, 900, 180, 180, 1313584200, 900, 179, 179, 1313585100, 900, 180, 180, 1313586000, 900, 180, 180, 1313586900, 900, 180, 180, 1313587800, 900, 180, 180, 1313588700, 900, 180, 180, 1313589600, 900, 179, 179, 1313590500, 900, 180, 180, 1313591400, 900, 180, 180, 1313592300, 900, 180, 180, 1313593200, 900, 180, 180, 1313594100, 900, 180, 180, 1313595000, 900, 179, 179, 1313595900, 900, 180, 180, 1313596800, 900, 180, 180, 1313597700, 900, 180, 180, 1313598600, 900, 180, 180, 1313599500, 900, 179, 179, 1313600400, 900, 180, 180, 1313601300, 900, 180, 180, 1313602200, 900, 180, 180, 1313603100, 900, 180, 180, 1313604000, 900, 180, 180, 1313604900, 900, 179, 179, 1313605800, 900, 180, 180, 1313606700, 900, 180, 180, 1313607600, 900, 180, 180, 1313608500, 900, 180, 180, 1313609400, 900, 180, 180, 1313610300, 900, 179, 179, 1313611200, 900, 180, 180, 1313612100, 900, 180, 180, 1313613000, 900, 180, 180, 1313613900, 900, 180, 180, 1313614800, 900, 179, 179, 1313615700, 900, 180, 180, 1313616600, 900, 180, 180, 1313617500, 900, 180, 180, 1313618400, 900, 180, 180, 1313619300, 900, 179, 179, 1313620200, 900, 180, 180, 1313621100, 900, 180, 180, 1313622000, 900, 180, 180, 1313622900, 900, 180, 180, 1313623800, 900, 180, 180, 1313624700, 900, 179, 179, 1313625600, 900, 180, 180, 1313626500, 900, 180, 180, 1313627400, 900, 180, 180, 1313628300, 900, 180, 180, 1313629200, 900, 180, 180, 1313630100, 900, 179, 179, 1313631000, 900, 180, 180, 1313631900, 900, 180, 180, 1313632800, 900, 180, 180, 1313633700, 900, 180, 180, 1313634600, 900, 179, 179, 1313635500, 900, 180, 180, 1313636400, 900, 180, 180, 1313637300, 900, 180, 180, 1313638200, 900, 180, 180, 1313639100, 900, 180, 180, 1313640000, 900, 179, 179, 1313640900, 900, 180, 180, 1313641800, 900, 180, 180, 1313642700, 900, 180, 180, 1313643600, 900, 180, 180, 1313644500, 900, 180, 180, 1313645400, 900, 179, 179, 1313646300, 900, 180, 180, 1313647200, 900, 180, 180, 1313648100, 900, 180, 180, 1313649000, 900, 180, 180, 1313649900, 900, 179, 179, 1313650800, 900, 180, 180, 1313651700, 900, 180, 180, 1313652600, 900, 180, 180, 1313653500, 900, 180, 180, 1313654400, 900, 179, 179, 1313655300, 900, 180, 180, 1313656200, 900, 180, 180, 1313657100, 900, 180, 180, 1313658000, 900, 180, 180, 1313658900, 900, 179, 179, 1313659800, 900, 180, 180, 1313660700, 900, 180, 180, 1313661600, 900, 180, 180, 1313662500, 900, 180, 180, 1313663400, 900, 180, 180, 1313664300, 900, 179, 179, 1313665200, 900, 180, 180, 1313666100, 900, 180, 180, 1313667000, 900, 180, 180, 1313667900, 900, 180, 180, 1313668800, 900, 179, 179, 1313669700, 900, 180, 180, 1313670600, 900, 180, 180, 1313671500, 900, 180, 180, 1313672400, 900, 180, 180, 1313673300, 900, 180, 180, 1313674200, 900, 179, 179, 1313675100, 900, 180, 180, 1313676000, 900, 180, 180, 1313676900, 900, 180, 180, 1313677800, 900, 180, 180, 1313678700, 900, 180, 180, 1313679600, 900, 179, 179, 1313680500, 900, 180, 180, 1313681400, 900, 180, 180, 1313682300, 900, 180, 180, 1313683200, 900, 180, 180, 1313684100, 900, 180, 180, 1313685000, 900, 179, 179, 1313685900, 900, 180, 180, 1313686800, 900, 180, 180, 1313687700, 900, 180, 180, 1313688600, 900, 180, 180, 1313689500, 900, 179, 179, 1313690400, 900, 180, 180, 1313691300, 900, 180, 180, 1313692200, 900, 180, 180, 1313693100, 900, 180, 180, 1313694000, 900, 179, 179, 1313694900, 900, 180, 180, 1313695800, 900, 180, 180, 1313696700, 900, 180, 180, 1313697600, 900, 180, 180, 1313698500, 900, 180, 180, 1313699400, 900, 179, 179, 1313700300, 900, 180, 180, 1313701200, 900, 180, 180, 1313702100, 900, 180, 180, 1313703000, 900, 180, 180, 1313703900, 900, 179, 179, 1313704800, 900, 180, 180, 1313705700, 900, 180, 180, 1313706600, 900, 180, 180, 1313707500, 900, 180, 180, 1313708400, 900, 180, 180, 1313709300, 900, 179, 179, 1313710200, 900, 180, 180, 1313711100, 900, 180, 180, 1313712000, 900, 180, 180, 1313712900, 900, 180, 180, 1313713800, 900, 180, 180, 1313714700, 900, 179, 179, 1313715600, 900, 180, 180, 1313716500, 900, 180, 180, 1313717400, 900, 180, 180, 1313718300, 900, 180, 180, 1313719200, 900, 179, 179, 1313720100, 900, 180, 180, 1313721000, 900, 180, 180, 1313721900, 900, 180, 180, 1313722800, 900, 180, 180, 1313723700, 900, 180, 180, 1313724600, 900, 179, 179, 1313725500, 900, 180, 180, 1313726400, 900, 180, 180, 1313727300, 900, 180, 180, 1313728200, 900, 180, 180, 1313729100, 900, 179, 179, 1313730000, 900, 180, 180, 1313730900, 900, 180, 180, 1313731800, 900, 180, 180, 1313732700, 900, 180, 180, 1313733600, 900, 180, 180, 1313734500, 900, 179, 179, 1313735400, 900, 180, 180, 1313736300, 900, 180, 180, 1313737200, 900, 180, 180, 1313738100, 900, 180, 180, 1313739000, 900, 179, 179, 1313739900, 900, 180, 180, 1313740800, 900, 180, 180, 1313741700, 900, 180, 180, 1313742600, 900, 180, 180, 1313743500, 900, 180, 180, 1313744400, 900, 179, 179, 1313745300, 900, 180, 180, 1313746200, 900, 180, 180, 1313747100, 900, 180, 180, 1313748000, 900, 180, 180, 1313748900, 900, 180, 180, 1313749800, 900, 179, 179, 1313750700, 900, 180, 180, 1313751600, 900, 180, 180, 1313752500, 900, 180, 180, 1313753400, 900, 180, 180, 1313754300, 900, 179, 179, 1313755200, 900, 180, 180, 1313756100, 900, 180, 180, 1313757000, 900, 180, 180, 1313757900, 900, 180, 180, 1313758800, 900, 180, 180, 1313759700, 900, 179, 179, 1313760600, 900, 180, 180, 1313761500, 900, 180, 180, 1313762400, 900, 180, 180, 1313763300, 900, 180, 180, 1313764200, 900, 180, 180, 1313765100, 900, 179, 179, 1313766000, 900, 180, 180, 1313766900, 900, 180, 180, 1313767800, 900, 180, 180, 1313768700, 900, 180, 180, 1313769600, 900, 179, 179, 1313770500, 900, 180, 180, 1313771400, 900, 180, 180, 1313772300, 900, 180, 180, 1313773200, 900, 180, 180, 1313774100, 900, 180, 180, 1313775000, 900, 179, 179, 1313775900, 900, 180, 180, 1313776800, 900, 180, 180, 1313777700, 900, 180, 180, 1313778600, 900, 180, 180, 1313779500, 900, 180, 180, 1313780400, 900, 179, 179, 1313781300, 900, 180, 180, 1313782200, 900, 180, 180, 1313783100, 900, 180, 180, 1313784000, 900, 180, 180, 1313784900, 900, 179, 179, 1313785800, 900, 180, 180, 1313786700, 900, 180, 180, 1313787600, 900, 180, 180, 1313788500, 900, 180, 180, 1313789400, 900, 180, 180, 1313790300, 900, 179, 179, 1313791200, 900, 180, 180, 1313792100, 900, 180, 180, 1313793000, 900, 180, 180, 1313793900, 900, 180, 180, 1313794800, 900, 179, 179, 1313795700, 900, 180, 180, 1313796600, 900, 180, 180, 1313797500, 900, 180, 180, 1313798400, 900, 180, 180, 1313799300, 900, 180, 180, 1313800200, 900, 179, 179, 1313801100, 900, 180, 180, 1313802000, 900, 180, 180, 1313802900, 900, 180, 180, 1313803800, 900, 180, 180, 1313804700, 900, 180, 180, 1313805600, 900, 179, 179, 1313806500, 900, 180, 180, 1313807400, 900, 180, 180, 1313808300, 900, 180, 180, 1313809200, 900, 180, 180, 1313810100, 900, 179, 179, 1313811000, 900, 180, 180, 1313811900, 900, 180, 180, 1313812800, 900, 180, 180, 1313813700, 900, 180, 180, 1313814600, 900, 180, 180, 1313815500, 900, 179, 179, 1313816400, 900, 180, 180, 1313817300, 900, 180, 180, 1313818200, 900, 180, 180, 1313819100, 900, 180, 180, 1313820000, 900, 179, 179, 1313820900, 900, 180, 180, 1313821800, 900, 180, 180, 1313822700, 900, 180, 180, 1313823600, 900, 180, 180, 1313824500, 900, 180, 180, 1313825400, 900, 179, 179, 1313826300, 900, 180, 180, 1313827200, 900, 180, 180, 1313828100, 900, 180, 180, 1313829000, 900, 180, 180, 1313829900, 900, 180, 180, 1313830800, 900, 179, 179, 1313831700, 900, 180, 180, 1313832600, 900, 180, 180, 1313833500, 900, 180, 180, 1313834400, 900, 180, 180, 1313835300, 900, 179, 179, 1313836200, 900, 180, 180, 1313837100, 900, 180, 180, 1313838000, 900, 180, 180, 1313838900, 900, 180, 180, 1313839800, 900, 180, 180, 1313840700, 900, 179, 179, 1313841600, 900, 180, 180, 1313842500, 900, 180, 180, 1313843400, 900, 180, 180, 1313844300, 900, 180, 180, 1313845200, 900, 179, 179, 1313846100, 900, 180, 180, 1313847000, 900, 180, 180, 1313847900, 900, 180, 180, 1313848800, 900, 180, 180, 1313849700, 900, 180, 180, 1313850600, 900, 179, 179, 1313851500, 900, 180, 180, 1313852400, 900, 180, 180, 1313853300, 900, 180, 180, 1313854200, 900, 180, 180, 1313855100, 900, 180, 180, 1313856000, 900, 179, 179, 1313856900, 900, 180, 180, 1313857800, 900, 180, 180, 1313858700, 900, 180, 180, 1313859600, 900, 180, 180, 1313860500, 900, 179, 179, 1313861400, 900, 180, 180, 1313862300, 900, 180, 180, 1313863200, 900, 180, 180, 1313864100, 900, 180, 180, 1313865000, 900, 180, 180, 1313865900, 900, 179, 179, 1313866800, 900, 180, 180, 1313867700, 900, 180, 180, 1313868600, 900, 180, 180, 1313869500, 900, 180, 180, 1313870400, 900, 179, 179, 1313871300, 900, 180, 180, 1313872200, 900, 180, 180, 1313873100, 900, 180, 180, 1313874000, 900, 180, 180, 1313874900, 900, 179, 179, 1313875800, 900, 180, 180, 1313876700, 900, 180, 180, 1313877600, 900, 180, 180, 1313878500, 900, 180, 180, 1313879400, 900, 180, 180, 1313880300, 900, 179, 179, 1313881200, 900, 180, 180, 1313882100, 900, 180, 180, 1313883000, 900, 180, 180, 1313883900, 900, 180, 180, 1313884800, 900, 180, 180, 1313885700, 900, 179, 179, 1313886600, 900, 180, 180, 1313887500, 900, 180, 180, 1313888400, 900, 180, 180, 1313889300, 900, 180, 180, 1313890200, 900, 179, 179, 1313891100, 900, 180, 180, 1313892000, 900, 180, 180, 1313892900, 900, 180, 180, 1313893800, 900, 180, 180, 1313894700, 900, 180, 180, 1313895600, 900, 179, 179, 1313896500, 900, 180, 180, 1313897400, 900, 180, 180, 1313898300, 900, 180, 180, 1313899200, 900, 180, 180, 1313900100, 900, 179, 179, 1313901000, 900, 180, 180, 1313901900, 900, 180, 180, 1313902800, 900, 180, 180, 1313903700, 900, 180, 180, 1313904600, 900, 180, 180, 1313905500, 900, 179, 179, 1313906400, 900, 180, 180, 1313907300, 900, 180, 180, 1313908200, 900, 180, 180, 1313909100, 900, 180, 180, 1313910000, 900, 179, 179, 1313910900, 900, 180, 180, 1313911800, 900, 180, 180, 1313912700, 900, 180, 180, 1313913600, 900, 180, 180, 1313914500, 900, 180, 180, 1313915400, 900, 179, 179, 1313916300, 900, 180, 180, 1313917200, 900, 180, 180, 1313918100, 900, 180, 180, 1313919000, 900, 180, 180, 1313919900, 900, 180, 180, 1313920800, 900, 179, 179, 1313921700, 900, 180, 180, 1313922600, 900, 180, 180, 1313923500, 900, 180, 180, 1313924400, 900, 180, 180, 1313925300, 900, 179, 179, 1313926200, 900, 180, 180, 1313927100, 900, 180, 180, 1313928000, 900, 180, 180, 1313928900, 900, 180, 180, 1313929800, 900, 180, 180, 1313930700, 900, 179, 179, 1313931600, 900, 180, 180, 1313932500, 900, 180, 180, 1313933400, 900, 180, 180, 1313934300, 900, 180, 180, 1313935200, 900, 179, 179, 1313936100, 900, 180, 180, 1313937000, 900, 180, 180, 1313937900, 900, 180, 180, 1313938800, 900, 180, 180, 1313939700, 900, 179, 179, 1313940600, 900, 180, 180, 1313941500, 900, 180, 180, 1313942400, 900, 180, 180, 1313943300, 900, 180, 180, 1313944200, 900, 179, 179, 1313945100, 900, 180, 180, 1313946000, 900, 180, 180, 1313946900, 900, 180, 180, 1313947800, 900, 180, 180, 1313948700, 900, 180, 180, 1313949600, 900, 179, 179, 1313950500, 900, 180, 180, 1313951400, 900, 180, 180, 1313952300, 900, 180, 180, 1313953200, 900, 180, 180, 1313954100, 900, 180, 180, 1313955000, 900, 179, 179, 1313955900, 900, 180, 180, 1313956800, 900, 180, 180, 1313957700, 900, 180, 180, 1313958600, 900, 180, 180, 1313959500, 900, 179, 179, 1313960400, 900, 180, 180, 1313961300, 900, 180, 180, 1313962200, 900, 144, 144, 1313962920, 60, 12, 12, 1313962980, 60, 12, 12, 1313963040, 60, 12, 12, 1313963100, 60, 12, 12, 1313963160, 60, 12, 12, 1313963220, 60, 12, 12, 1313963280, 60, 12, 12, 1313963340, 60, 12, 12, 1313963400, 60, 12, 12, 1313963460, 60, 12, 12, 1313963520, 60, 12, 12, 1313963580, 60, 12, 12, 1313963640, 60, 12, 12, 1313963700, 60, 12, 12, 1313963760, 60, 12, 12, 1313963820, 60, 12, 12, 1313963880, 60, 12, 12, 1313963940, 60, 12, 12, 1313964000, 60, 12, 12, 1313964060, 60, 12, 12, 1313964120, 60, 12, 12, 1313964180, 60, 12, 12, 1313964240, 60, 12, 12, 1313964300, 60, 12, 12, 1313964360, 60, 12, 12, 1313964420, 60, 12, 12, 1313964480, 60, 12, 12, 1313964540, 60, 12, 12, 1313964600, 60, 12, 12, 1313964660, 60, 12, 12, 1313964720, 60, 12, 12, 1313964780, 60, 12, 12, 1313964840, 60, 12, 12, 1313964900, 60, 12, 12, 1313964960, 60, 12, 12, 1313965020, 60, 12, 12, 1313965080, 60, 12, 12, 1313965140, 60, 11, 11, 1313965200, 60, 12, 12, 1313965260, 60, 12, 12, 1313965320, 60, 12, 12, 1313965380, 60, 12, 12, 1313965440, 60, 12, 12, 1313965500, 60, 12, 12, 1313965560, 60, 12, 12, 1313965620, 60, 12, 12, 1313965680, 60, 12, 12, 1313965740, 60, 12, 12, 1313965800, 60, 12, 12, 1313965860, 60, 12, 12, 1313965920, 60, 12, 12, 1313965980, 60, 12, 12, 1313966040, 60, 12, 12, 1313966100, 60, 12, 12, 1313966160, 60, 12, 12, 1313966220, 60, 12, 12, 1313966280, 60, 12, 12, 1313966340, 60, 12, 12, 1313966400, 60, 12, 12, 1313966460, 60, 12, 12, 1313966520, 60, 12, 12, 1313966580, 60, 12, 12, 1313966640, 60, 12, 12, 1313966700, 60, 12, 12, 1313966760, 60, 12, 12, 1313966820, 60, 12, 12, 1313966880, 60, 12, 12, 1313966940, 60, 12, 12, 1313967000, 60, 12, 12, 1313967060, 60, 12, 12, 1313967120, 60, 12, 12, 1313967180, 60, 12, 12, 1313967240, 60, 12, 12, 1313967300, 60, 12, 12, 1313967360, 60, 12, 12, 1313967420, 60, 12, 12, 1313967480, 60, 12, 12, 1313967540, 60, 12, 12, 1313967600, 60, 12, 12, 1313967660, 60, 12, 12, 1313967720, 60, 12, 12, 1313967780, 60, 12, 12, 1313967840, 60, 12, 12, 1313967900, 60, 12, 12, 1313967960, 60, 12, 12, 1313968020, 60, 12, 12, 1313968080, 60, 12, 12, 1313968140, 60, 12, 12, 1313968200, 60, 12, 12, 1313968260, 60, 12, 12, 1313968320, 60, 12, 12, 1313968380, 60, 12, 12, 1313968440, 60, 12, 12, 1313968500, 60, 12, 12, 1313968560, 60, 12, 12, 1313968620, 60, 12, 12, 1313968680, 60, 12, 12, 1313968740, 60, 12, 12, 1313968800, 60, 12, 12, 1313968860, 60, 12, 12, 1313968920, 60, 12, 12, 1313968980, 60, 12, 12, 1313969040, 60, 12, 12, 1313969100, 60, 12, 12, 1313969160, 60, 12, 12, 1313969220, 60, 12, 12, 1313969280, 60, 12, 12, 1313969340, 60, 12, 12, 1313969400, 60, 12, 12, 1313969460, 60, 12, 12, 1313969520, 60, 12, 12, 1313969580, 60, 12, 12, 1313969640, 60, 12, 12, 1313969700, 60, 12, 12, 1313969760, 60, 12, 12, 1313969820, 60, 12, 12, 1313969880, 60, 12, 12, 1313969940, 60, 12, 12, 1313970000, 60, 12, 12, 1313970060, 60, 12, 12, 1313970120, 60, 12, 12, 1313970180, 60, 11, 11, 1313970240, 60, 12, 12, 1313970300, 60, 12, 12, 1313970360, 60, 12, 12, 1313970420, 60, 12, 12, 1313970480, 60, 12, 12, 1313970540, 60, 12, 12, 1313970600, 60, 12, 12, 1313970660, 60, 12, 12, 1313970720, 60, 12, 12, 1313970780, 60, 12, 12, 1313970840, 60, 12, 12, 1313970900, 60, 12, 12, 1313970960, 60, 12, 12, 1313971020, 60, 12, 12, 1313971080, 60, 12, 12, 1313971140, 60, 12, 12, 1313971200, 60, 12, 12, 1313971260, 60, 12, 12, 1313971320, 60, 12, 12, 1313971380, 60, 12, 12, 1313971440, 60, 12, 12, 1313971500, 60, 12, 12, 1313971560, 60, 12, 12, 1313971620, 60, 12, 12, 1313971680, 60, 12, 12, 1313971740, 60, 12, 12, 1313971800, 60, 12, 12, 1313971860, 60, 12, 12, 1313971920, 60, 12, 12, 1313971980, 60, 12, 12, 1313972040, 60, 12, 12, 1313972100, 60, 12, 12, 1313972160, 60, 12, 12, 1313972220, 60, 12, 12, 1313972280, 60, 12, 12, 1313972340, 60, 12, 12, 1313972400, 60, 12, 12, 1313972460, 60, 12, 12, 1313972520, 60, 12, 12, 1313972580, 60, 12, 12, 1313972640, 60, 12, 12, 1313972700, 60, 12, 12, 1313972760, 60, 12, 12, 1313972820, 60, 12, 12, 1313972880, 60, 12, 12, 1313972940, 60, 12, 12, 1313973000, 60, 12, 12, 1313973060, 60, 12, 12, 1313973120, 60, 12, 12, 1313973180, 60, 12, 12, 1313973240, 60, 12, 12, 1313973300, 60, 12, 12, 1313973360, 60, 12, 12, 1313973420, 60, 12, 12, 1313973480, 60, 12, 12, 1313973540, 60, 12, 12, 1313973600, 60, 12, 12, 1313973660, 60, 12, 12, 1313973720, 60, 12, 12, 1313973780, 60, 12, 12, 1313973840, 60, 12, 12, 1313973900, 60, 12, 12, 1313973960, 60, 12, 12, 1313974020, 60, 12, 12, 1313974080, 60, 12, 12, 1313974140, 60, 12, 12, 1313974200, 60, 12, 12, 1313974260, 60, 12, 12, 1313974320, 60, 12, 12, 1313974380, 60, 12, 12, 1313974440, 60, 12, 12, 1313974500, 60, 12, 12, 1313974560, 60, 12, 12, 1313974620, 60, 12, 12, 1313974680, 60, 12, 12, 1313974740, 60, 12, 12, 1313974800, 60, 12, 12, 1313974860, 60, 12, 12, 1313974920, 60, 12, 12, 1313974980, 60, 12, 12, 1313975040, 60, 12, 12, 1313975100, 60, 12, 12, 1313975160, 60, 12, 12, 1313975220, 60, 11, 11, 1313975280, 60, 12, 12, 1313975340, 60, 12, 12, 1313975400, 60, 12, 12, 1313975460, 60, 12, 12, 1313975520, 60, 12, 12, 1313975580, 60, 12, 12, 1313975640, 60, 12, 12, 1313975700, 60, 12, 12, 1313975760, 60, 12, 12, 1313975820, 60, 12, 12, 1313975880, 60, 12, 12, 1313975940, 60, 12, 12, 1313976000, 60, 12, 12, 1313976060, 60, 12, 12, 1313976120, 60, 12, 12, 1313976180, 60, 12, 12, 1313976240, 60, 12, 12, 1313976300, 60, 12, 12, 1313976360, 60, 12, 12, 1313976420, 60, 12, 12, 1313976480, 60, 12, 12, 1313976540, 60, 12, 12, 1313976600, 60, 12, 12, 1313976660, 60, 12, 12, 1313976720, 60, 12, 12, 1313976780, 60, 12, 12, 1313976840, 60, 12, 12, 1313976900, 60, 12, 12, 1313976960, 60, 12, 12, 1313977020, 60, 12, 12, 1313977080, 60, 12, 12, 1313977140, 60, 12, 12, 1313977200, 60, 12, 12, 1313977260, 60, 12, 12, 1313977320, 60, 12, 12, 1313977380, 60, 12, 12, 1313977440, 60, 12, 12, 1313977500, 60, 12, 12, 1313977560, 60, 12, 12, 1313977620, 60, 12, 12, 1313977680, 60, 12, 12, 1313977740, 60, 12, 12, 1313977800, 60, 12, 12, 1313977860, 60, 12, 12, 1313977920, 60, 12, 12, 1313977980, 60, 12, 12, 1313978040, 60, 12, 12, 1313978100, 60, 12, 12, 1313978160, 60, 12, 12, 1313978220, 60, 12, 12, 1313978280, 60, 12, 12, 1313978340, 60, 12, 12, 1313978400, 60, 12, 12, 1313978460, 60, 12, 12, 1313978520, 60, 12, 12, 1313978580, 60, 12, 12, 1313978640, 60, 12, 12, 1313978700, 60, 12, 12, 1313978760, 60, 12, 12, 1313978820, 60, 12, 12, 1313978880, 60, 12, 12, 1313978940, 60, 12, 12, 1313979000, 60, 12, 12, 1313979060, 60, 12, 12, 1313979120, 60, 12, 12, 1313979180, 60, 12, 12, 1313979240, 60, 12, 12, 1313979300, 60, 12, 12, 1313979360, 60, 12, 12, 1313979420, 60, 12, 12, 1313979480, 60, 12, 12, 1313979540, 60, 12, 12, 1313979600, 60, 12, 12, 1313979660, 60, 12, 12, 1313979720, 60, 12, 12, 1313979780, 60, 12, 12, 1313979840, 60, 12, 12, 1313979900, 60, 12, 12, 1313979960, 60, 12, 12, 1313980020, 60, 12, 12, 1313980080, 60, 12, 12, 1313980140, 60, 12, 12, 1313980200, 60, 12, 12, 1313980260, 60, 11, 11, 1313980320, 60, 12, 12, 1313980380, 60, 12, 12, 1313980440, 60, 12, 12, 1313980500, 60, 12, 12, 1313980560, 60, 12, 12, 1313980620, 60, 12, 12, 1313980680, 60, 12, 12, 1313980740, 60, 12, 12, 1313980800, 60, 12, 12, 1313980860, 60, 12, 12, 1313980920, 60, 12, 12, 1313980980, 60, 12, 12, 1313981040, 60, 12, 12, 1313981100, 60, 12, 12, 1313981160, 60, 12, 12, 1313981220, 60, 12, 12, 1313981280, 60, 12, 12, 1313981340, 60, 12, 12, 1313981400, 60, 12, 12, 1313981460, 60, 12, 12, 1313981520, 60, 12, 12, 1313981580, 60, 12, 12, 1313981640, 60, 12, 12, 1313981700, 60, 12, 12, 1313981760, 60, 12, 12, 1313981820, 60, 12, 12, 1313981880, 60, 12, 12, 1313981940, 60, 12, 12, 1313982000, 60, 12, 12, 1313982060, 60, 12, 12, 1313982120, 60, 12, 12, 1313982180, 60, 12, 12, 1313982240, 60, 12, 12, 1313982300, 60, 12, 12, 1313982360, 60, 12, 12, 1313982420, 60, 12, 12, 1313982480, 60, 12, 12, 1313982540, 60, 12, 12, 1313982600, 60, 12, 12, 1313982660, 60, 12, 12, 1313982720, 60, 12, 12, 1313982780, 60, 12, 12, 1313982840, 60, 12, 12, 1313982900, 60, 12, 12, 1313982960, 60, 12, 12, 1313983020, 60, 12, 12, 1313983080, 60, 12, 12, 1313983140, 60, 12, 12, 1313983200, 60, 12, 12, 1313983260, 60, 12, 12, 1313983320, 60, 12, 12, 1313983380, 60, 12, 12, 1313983440, 60, 12, 12, 1313983500, 60, 12, 12, 1313983560, 60, 12, 12, 1313983620, 60, 12, 12, 1313983680, 60, 12, 12, 1313983740, 60, 12, 12, 1313983800, 60, 12, 12, 1313983860, 60, 12, 12, 1313983920, 60, 12, 12, 1313983980, 60, 12, 12, 1313984040, 60, 12, 12, 1313984100, 60, 12, 12, 1313984160, 60, 12, 12, 1313984220, 60, 12, 12, 1313984280, 60, 12, 12, 1313984340, 60, 12, 12, 1313984400, 60, 12, 12, 1313984460, 60, 12, 12, 1313984520, 60, 12, 12, 1313984580, 60, 12, 12, 1313984640, 60, 12, 12, 1313984700, 60, 12, 12, 1313984760, 60, 12, 12, 1313984820, 60, 12, 12, 1313984880, 60, 12, 12, 1313984940, 60, 12, 12, 1313985000, 60, 12, 12, 1313985060, 60, 12, 12, 1313985120, 60, 12, 12, 1313985180, 60, 12, 12, 1313985240, 60, 12, 12, 1313985300, 60, 11, 11, 1313985360, 60, 12, 12, 1313985420, 60, 12, 12, 1313985480, 60, 12, 12, 1313985540, 60, 12, 12, 1313985600, 60, 12, 12, 1313985660, 60, 12, 12, 1313985720, 60, 12, 12, 1313985780, 60, 12, 12, 1313985840, 60, 12, 12, 1313985900, 60, 12, 12, 1313985960, 60, 12, 12, 1313986020, 60, 12, 12, 1313986080, 60, 12, 12, 1313986140, 60, 12, 12, 1313986200, 60, 12, 12, 1313986260, 60, 12, 12, 1313986320, 60, 12, 12, 1313986380, 60, 12, 12, 1313986440, 60, 12, 12, 1313986500, 60, 12, 12, 1313986560, 60, 12, 12, 1313986620, 60, 12, 12, 1313986680, 60, 12, 12, 1313986740, 60, 12, 12, 1313986800, 60, 12, 12, 1313986860, 60, 12, 12, 1313986920, 60, 12, 12, 1313986980, 60, 12, 12, 1313987040, 60, 12, 12, 1313987100, 60, 12, 12, 1313987160, 60, 12, 12, 1313987220, 60, 12, 12, 1313987280, 60, 12, 12, 1313987340, 60, 12, 12, 1313987400, 60, 12, 12, 1313987460, 60, 12, 12, 1313987520, 60, 12, 12, 1313987580, 60, 12, 12, 1313987640, 60, 12, 12, 1313987700, 60, 12, 12, 1313987760, 60, 12, 12, 1313987820, 60, 12, 12, 1313987880, 60, 12, 12, 1313987940, 60, 12, 12, 1313988000, 60, 12, 12, 1313988060, 60, 12, 12, 1313988120, 60, 12, 12, 1313988180, 60, 12, 12, 1313988240, 60, 12, 12, 1313988300, 60, 12, 12, 1313988360, 60, 12, 12, 1313988420, 60, 12, 12, 1313988480, 60, 12, 12, 1313988540, 60, 12, 12, 1313988600, 60, 12, 12, 1313988660, 60, 12, 12, 1313988720, 60, 12, 12, 1313988780, 60, 12, 12, 1313988840, 60, 12, 12, 1313988900, 60, 12, 12, 1313988960, 60, 12, 12, 1313989020, 60, 12, 12, 1313989080, 60, 12, 12, 1313989140, 60, 12, 12, 1313989200, 60, 12, 12, 1313989260, 60, 12, 12, 1313989320, 60, 12, 12, 1313989380, 60, 12, 12, 1313989440, 60, 12, 12, 1313989500, 60, 12, 12, 1313989560, 60, 12, 12, 1313989620, 60, 12, 12, 1313989680, 60, 12, 12, 1313989740, 60, 12, 12, 1313989800, 60, 12, 12, 1313989860, 60, 12, 12, 1313989920, 60, 12, 12, 1313989980, 60, 12, 12, 1313990040, 60, 12, 12, 1313990100, 60, 12, 12, 1313990160, 60, 12, 12, 1313990220, 60, 12, 12, 1313990280, 60, 12, 12, 1313990340, 60, 11, 11, 1313990400, 60, 12, 12, 1313990460, 60, 12, 12, 1313990520, 60, 12, 12, 1313990580, 60, 12, 12, 1313990640, 60, 12, 12, 1313990700, 60, 12, 12, 1313990760, 60, 12, 12, 1313990820, 60, 12, 12, 1313990880, 60, 12, 12, 1313990940, 60, 12, 12, 1313991000, 60, 12, 12, 1313991060, 60, 12, 12, 1313991120, 60, 12, 12, 1313991180, 60, 12, 12, 1313991240, 60, 12, 12, 1313991300, 60, 12, 12, 1313991360, 60, 12, 12, 1313991420, 60, 12, 12, 1313991480, 60, 12, 12, 1313991540, 60, 12, 12, 1313991600, 60, 12, 12, 1313991660, 60, 12, 12, 1313991720, 60, 12, 12, 1313991780, 60, 12, 12, 1313991840, 60, 12, 12, 1313991900, 60, 12, 12, 1313991960, 60, 12, 12, 1313992020, 60, 12, 12, 1313992080, 60, 12, 12, 1313992140, 60, 12, 12, 1313992200, 60, 12, 12, 1313992260, 60, 12, 12, 1313992320, 60, 12, 12, 1313992380, 60, 12, 12, 1313992440, 60, 12, 12, 1313992500, 60, 12, 12, 1313992560, 60, 12, 12, 1313992620, 60, 12, 12, 1313992680, 60, 12, 12, 1313992740, 60, 12, 12, 1313992800, 60, 12, 12, 1313992860, 60, 12, 12, 1313992920, 60, 12, 12, 1313992980, 60, 12, 12, 1313993040, 60, 12, 12, 1313993100, 60, 12, 12, 1313993160, 60, 12, 12, 1313993220, 60, 12, 12, 1313993280, 60, 12, 12, 1313993340, 60, 12, 12, 1313993400, 60, 12, 12, 1313993460, 60, 12, 12, 1313993520, 60, 12, 12, 1313993580, 60, 12, 12, 1313993640, 60, 12, 12, 1313993700, 60, 12, 12, 1313993760, 60, 12, 12, 1313993820, 60, 12, 12, 1313993880, 60, 12, 12, 1313993940, 60, 12, 12, 1313994000, 60, 12, 12, 1313994060, 60, 12, 12, 1313994120, 60, 12, 12, 1313994180, 60, 12, 12, 1313994240, 60, 12, 12, 1313994300, 60, 12, 12, 1313994360, 60, 12, 12, 1313994420, 60, 12, 12, 1313994480, 60, 12, 12, 1313994540, 60, 12, 12, 1313994600, 60, 12, 12, 1313994660, 60, 12, 12, 1313994720, 60, 12, 12, 1313994780, 60, 12, 12, 1313994840, 60, 12, 12, 1313994900, 60, 12, 12, 1313994960, 60, 12, 12, 1313995020, 60, 12, 12, 1313995080, 60, 12, 12, 1313995140, 60, 12, 12, 1313995200, 60, 12, 12, 1313995260, 60, 12, 12, 1313995320, 60, 12, 12, 1313995380, 60, 11, 11, 1313995440, 60, 12, 12, 1313995500, 60, 12, 12, 1313995560, 60, 12, 12, 1313995620, 60, 12, 12, 1313995680, 60, 12, 12, 1313995740, 60, 12, 12, 1313995800, 60, 12, 12, 1313995860, 60, 12, 12, 1313995920, 60, 12, 12, 1313995980, 60, 12, 12, 1313996040, 60, 12, 12, 1313996100, 60, 12, 12, 1313996160, 60, 12, 12, 1313996220, 60, 12, 12, 1313996280, 60, 12, 12, 1313996340, 60, 12, 12, 1313996400, 60, 12, 12, 1313996460, 60, 12, 12, 1313996520, 60, 12, 12, 1313996580, 60, 12, 12, 1313996640, 60, 12, 12, 1313996700, 60, 12, 12, 1313996760, 60, 12, 12, 1313996820, 60, 12, 12, 1313996880, 60, 12, 12, 1313996940, 60, 12, 12, 1313997000, 60, 12, 12, 1313997060, 60, 12, 12, 1313997120, 60, 12, 12, 1313997180, 60, 12, 12, 1313997240, 60, 12, 12, 1313997300, 60, 12, 12, 1313997360, 60, 12, 12, 1313997420, 60, 12, 12, 1313997480, 60, 12, 12, 1313997540, 60, 12, 12, 1313997600, 60, 12, 12, 1313997660, 60, 12, 12, 1313997720, 60, 12, 12, 1313997780, 60, 12, 12, 1313997840, 60, 12, 12, 1313997900, 60, 12, 12, 1313997960, 60, 12, 12, 1313998020, 60, 12, 12, 1313998080, 60, 12, 12, 1313998140, 60, 12, 12, 1313998200, 60, 12, 12, 1313998260, 60, 12, 12, 1313998320, 60, 12, 12, 1313998380, 60, 12, 12, 1313998440, 60, 12, 12, 1313998500, 60, 12, 12, 1313998560, 60, 12, 12, 1313998620, 60, 12, 12, 1313998680, 60, 12, 12, 1313998740, 60, 12, 12, 1313998800, 60, 12, 12, 1313998860, 60, 12, 12, 1313998920, 60, 12, 12, 1313998980, 60, 12, 12, 1313999040, 60, 12, 12, 1313999100, 60, 12, 12, 1313999160, 60, 12, 12, 1313999220, 60, 12, 12, 1313999280, 60, 12, 12, 1313999340, 60, 12, 12, 1313999400, 60, 12, 12, 1313999460, 60, 12, 12, 1313999520, 60, 12, 12, 1313999580, 60, 12, 12, 1313999640, 60, 12, 12, 1313999700, 60, 12, 12, 1313999760, 60, 12, 12, 1313999820, 60, 12, 12, 1313999880, 60, 12, 12, 1313999940, 60, 12, 12, 1314000000, 60, 12, 12, 1314000060, 60, 12, 12, 1314000120, 60, 12, 12, 1314000180, 60, 12, 12, 1314000240, 60, 12, 12, 1314000300, 60, 12, 12, 1314000360, 60, 12, 12, 1314000420, 60, 11, 11, 1314000480, 60, 12, 12, 1314000540, 60, 12, 12, 1314000600, 60, 12, 12, 1314000660, 60, 12, 12, 1314000720, 60, 12, 12, 1314000780, 60, 12, 12, 1314000840, 60, 12, 12, 1314000900, 60, 12, 12, 1314000960, 60, 12, 12, 1314001020, 60, 12, 12, 1314001080, 60, 12, 12, 1314001140, 60, 12, 12, 1314001200, 60, 12, 12, 1314001260, 60, 12, 12, 1314001320, 60, 12, 12, 1314001380, 60, 12, 12, 1314001440, 60, 12, 12, 1314001500, 60, 12, 12, 1314001560, 60, 12, 12, 1314001620, 60, 12, 12, 1314001680, 60, 12, 12, 1314001740, 60, 12, 12, 1314001800, 60, 12, 12, 1314001860, 60, 12, 12, 1314001920, 60, 12, 12, 1314001980, 60, 12, 12, 1314002040, 60, 12, 12, 1314002100, 60, 12, 12, 1314002160, 60, 12, 12, 1314002220, 60, 12, 12, 1314002280, 60, 12, 12, 1314002340, 60, 12, 12, 1314002400, 60, 12, 12, 1314002460, 60, 12, 12, 1314002520, 60, 12, 12, 1314002580, 60, 12, 12, 1314002640, 60, 12, 12, 1314002700, 60, 12, 12, 1314002760, 60, 12, 12, 1314002820, 60, 12, 12, 1314002880, 60, 12, 12, 1314002940, 60, 12, 12, 1314003000, 60, 12, 12, 1314003060, 60, 12, 12, 1314003120, 60, 12, 12, 1314003180, 60, 12, 12, 1314003240, 60, 12, 12, 1314003300, 60, 12, 12, 1314003360, 60, 12, 12, 1314003420, 60, 12, 12, 1314003480, 60, 12, 12, 1314003540, 60, 12, 12, 1314003600, 60, 12, 12, 1314003660, 60, 12, 12, 1314003720, 60, 12, 12, 1314003780, 60, 12, 12, 1314003840, 60, 12, 12, 1314003900, 60, 12, 12, 1314003960, 60, 12, 12, 1314004020, 60, 12, 12, 1314004080, 60, 12, 12, 1314004140, 60, 12, 12, 1314004200, 60, 12, 12, 1314004260, 60, 12, 12, 1314004320, 60, 12, 12, 1314004380, 60, 12, 12, 1314004440, 60, 12, 12, 1314004500, 60, 12, 12, 1314004560, 60, 12, 12, 1314004620, 60, 12, 12, 1314004680, 60, 12, 12, 1314004740, 60, 12, 12, 1314004800, 60, 12, 12, 1314004860, 60, 12, 12, 1314004920, 60, 12, 12, 1314004980, 60, 12, 12, 1314005040, 60, 12, 12, 1314005100, 60, 12, 12, 1314005160, 60, 12, 12, 1314005220, 60, 12, 12, 1314005280, 60, 12, 12, 1314005340, 60, 12, 12, 1314005400, 60, 12, 12, 1314005460, 60, 12, 12, 1314005520, 60, 11, 11, 1314005580, 60, 12, 12, 1314005640, 60, 12, 12, 1314005700, 60, 12, 12, 1314005760, 60, 12, 12, 1314005820, 60, 12, 12, 1314005880, 60, 12, 12, 1314005940, 60, 12, 12, 1314006000, 60, 12, 12, 1314006060, 60, 12, 12, 1314006120, 60, 12, 12, 1314006180, 60, 12, 12, 1314006240, 60, 12, 12, 1314006300, 60, 12, 12, 1314006360, 60, 12, 12, 1314006420, 60, 12, 12, 1314006480, 60, 12, 12, 1314006540, 60, 12, 12, 1314006600, 60, 12, 12, 1314006660, 60, 12, 12, 1314006720, 60, 12, 12, 1314006780, 60, 12, 12, 1314006840, 60, 12, 12, 1314006900, 60, 12, 12, 1314006960, 60, 12, 12, 1314007020, 60, 12, 12, 1314007080, 60, 12, 12, 1314007140, 60, 12, 12, 1314007200, 60, 12, 12, 1314007260, 60, 12, 12, 1314007320, 60, 12, 12, 1314007380, 60, 12, 12, 1314007440, 60, 12, 12, 1314007500, 60, 12, 12, 1314007560, 60, 12, 12, 1314007620, 60, 12, 12, 1314007680, 60, 12, 12, 1314007740, 60, 12, 12, 1314007800, 60, 12, 12, 1314007860, 60, 12, 12, 1314007920, 60, 12, 12, 1314007980, 60, 12, 12, 1314008040, 60, 12, 12, 1314008100, 60, 12, 12, 1314008160, 60, 12, 12, 1314008220, 60, 12, 12, 1314008280, 60, 12, 12, 1314008340, 60, 12, 12, 1314008400, 60, 12, 12, 1314008460, 60, 12, 12, 1314008520, 60, 12, 12, 1314008580, 60, 12, 12, 1314008640, 60, 12, 12, 1314008700, 60, 12, 12, 1314008760, 60, 12, 12, 1314008820, 60, 12, 12, 1314008880, 60, 12, 12, 1314008940, 60, 12, 12, 1314009000, 60, 12, 12, 1314009060, 60, 12, 12, 1314009120, 60, 12, 12, 1314009180, 60, 12, 12, 1314009240, 60, 12, 12, 1314009300, 60, 12, 12, 1314009360, 60, 12, 12, 1314009420, 60, 12, 12, 1314009480, 60, 12, 12, 1314009540, 60, 12, 12, 1314009600, 60, 12, 12, 1314009660, 60, 12, 12, 1314009720, 60, 12, 12, 1314009780, 60, 12, 12, 1314009840, 60, 12, 12, 1314009900, 60, 12, 12, 1314009960, 60, 12, 12, 1314010020, 60, 12, 12, 1314010080, 60, 12, 12, 1314010140, 60, 12, 12, 1314010200, 60, 12, 12, 1314010260, 60, 12, 12, 1314010320, 60, 12, 12, 1314010380, 60, 12, 12, 1314010440, 60, 12, 12, 1314010500, 60, 12, 12, 1314010560, 60, 11, 11, 1314010620, 60, 12, 12, 1314010680, 60, 12, 12, 1314010740, 60, 12, 12, 1314010800, 60, 12, 12, 1314010860, 60, 12, 12, 1314010920, 60, 12, 12, 1314010980, 60, 12, 12, 1314011040, 60, 12, 12, 1314011100, 60, 12, 12, 1314011160, 60, 12, 12, 1314011220, 60, 12, 12, 1314011280, 60, 12, 12, 1314011340, 60, 12, 12, 1314011400, 60, 12, 12, 1314011460, 60, 12, 12, 1314011520, 60, 12, 12, 1314011580, 60, 12, 12, 1314011640, 60, 12, 12, 1314011700, 60, 12, 12, 1314011760, 60, 12, 12, 1314011820, 60, 12, 12, 1314011880, 60, 12, 12, 1314011940, 60, 12, 12, 1314012000, 60, 12, 12, 1314012060, 60, 12, 12, 1314012120, 60, 12, 12, 1314012180, 60, 12, 12, 1314012240, 60, 12, 12, 1314012300, 60, 12, 12, 1314012360, 60, 12, 12, 1314012420, 60, 12, 12, 1314012480, 60, 12, 12, 1314012540, 60, 12, 12, 1314012600, 60, 12, 12, 1314012660, 60, 12, 12, 1314012720, 60, 12, 12, 1314012780, 60, 12, 12, 1314012840, 60, 12, 12, 1314012900, 60, 12, 12, 1314012960, 60, 12, 12, 1314013020, 60, 12, 12, 1314013080, 60, 12, 12, 1314013140, 60, 12, 12, 1314013200, 60, 12, 12, 1314013260, 60, 12, 12, 1314013320, 60, 12, 12, 1314013380, 60, 12, 12, 1314013440, 60, 12, 12, 1314013500, 60, 12, 12, 1314013560, 60, 12, 12, 1314013620, 60, 12, 12, 1314013680, 60, 12, 12, 1314013740, 60, 12, 12, 1314013800, 60, 12, 12, 1314013860, 60, 12, 12, 1314013920, 60, 12, 12, 1314013980, 60, 12, 12, 1314014040, 60, 12, 12, 1314014100, 60, 12, 12, 1314014160, 60, 12, 12, 1314014220, 60, 12, 12, 1314014280, 60, 12, 12, 1314014340, 60, 12, 12, 1314014400, 60, 12, 12, 1314014460, 60, 12, 12, 1314014520, 60, 12, 12, 1314014580, 60, 12, 12, 1314014640, 60, 12, 12, 1314014700, 60, 12, 12, 1314014760, 60, 12, 12, 1314014820, 60, 12, 12, 1314014880, 60, 12, 12, 1314014940, 60, 12, 12, 1314015000, 60, 12, 12, 1314015060, 60, 12, 12, 1314015120, 60, 12, 12, 1314015180, 60, 12, 12, 1314015240, 60, 12, 12, 1314015300, 60, 12, 12, 1314015360, 60, 12, 12, 1314015420, 60, 12, 12, 1314015480, 60, 12, 12, 1314015540, 60, 12, 12, 1314015600, 60, 11, 11, 1314015660, 60, 12, 12, 1314015720, 60, 12, 12, 1314015780, 60, 12, 12, 1314015840, 60, 12, 12, 1314015900, 60, 12, 12, 1314015960, 60, 12, 12, 1314016020, 60, 12, 12, 1314016080, 60, 12, 12, 1314016140, 60, 12, 12, 1314016200, 60, 12, 12, 1314016260, 60, 12, 12, 1314016320, 60, 12, 12, 1314016380, 60, 12, 12, 1314016440, 60, 12, 12, 1314016500, 60, 12, 12, 1314016560, 60, 12, 12, 1314016620, 60, 12, 12, 1314016680, 60, 12, 12, 1314016740, 60, 12, 12, 1314016800, 60, 12, 12, 1314016860, 60, 12, 12, 1314016920, 60, 12, 12, 1314016980, 60, 12, 12, 1314017040, 60, 12, 12, 1314017100, 60, 12, 12, 1314017160, 60, 12, 12, 1314017220, 60, 12, 12, 1314017280, 60, 12, 12, 1314017340, 60, 12, 12, 1314017400, 60, 12, 12, 1314017460, 60, 12, 12, 1314017520, 60, 12, 12, 1314017580, 60, 12, 12, 1314017640, 60, 12, 12, 1314017700, 60, 12, 12, 1314017760, 60, 12, 12, 1314017820, 60, 12, 12, 1314017880, 60, 12, 12, 1314017940, 60, 12, 12, 1314018000, 60, 12, 12, 1314018060, 60, 12, 12, 1314018120, 60, 12, 12, 1314018180, 60, 12, 12, 1314018240, 60, 12, 12, 1314018300, 60, 12, 12, 1314018360, 60, 12, 12, 1314018420, 60, 12, 12, 1314018480, 60, 12, 12, 1314018540, 60, 12, 12, 1314018600, 60, 12, 12, 1314018660, 60, 12, 12, 1314018720, 60, 12, 12, 1314018780, 60, 12, 12, 1314018840, 60, 12, 12, 1314018900, 60, 12, 12, 1314018960, 60, 12, 12, 1314019020, 60, 12, 12, 1314019080, 60, 12, 12, 1314019140, 60, 12, 12, 1314019200, 60, 12, 12, 1314019260, 60, 12, 12, 1314019320, 60, 12, 12, 1314019380, 60, 12, 12, 1314019440, 60, 12, 12, 1314019500, 60, 12, 12, 1314019560, 60, 12, 12, 1314019620, 60, 12, 12, 1314019680, 60, 12, 12, 1314019740, 60, 12, 12, 1314019800, 60, 12, 12, 1314019860, 60, 12, 12, 1314019920, 60, 12, 12, 1314019980, 60, 12, 12, 1314020040, 60, 12, 12, 1314020100, 60, 12, 12, 1314020160, 60, 12, 12, 1314020220, 60, 12, 12, 1314020280, 60, 12, 12, 1314020340, 60, 12, 12, 1314020400, 60, 12, 12, 1314020460, 60, 12, 12, 1314020520, 60, 12, 12, 1314020580, 60, 12, 12, 1314020640, 60, 11, 11, 1314020700, 60, 12, 12, 1314020760, 60, 12, 12, 1314020820, 60, 12, 12, 1314020880, 60, 12, 12, 1314020940, 60, 12, 12, 1314021000, 60, 12, 12, 1314021060, 60, 12, 12, 1314021120, 60, 12, 12, 1314021180, 60, 12, 12, 1314021240, 60, 12, 12, 1314021300, 60, 12, 12, 1314021360, 60, 12, 12, 1314021420, 60, 12, 12, 1314021480, 60, 12, 12, 1314021540, 60, 12, 12, 1314021600, 60, 12, 12, 1314021660, 60, 12, 12, 1314021720, 60, 12, 12, 1314021780, 60, 12, 12, 1314021840, 60, 12, 12, 1314021900, 60, 12, 12, 1314021960, 60, 12, 12, 1314022020, 60, 12, 12, 1314022080, 60, 12, 12, 1314022140, 60, 12, 12, 1314022200, 60, 12, 12, 1314022260, 60, 12, 12, 1314022320, 60, 12, 12, 1314022380, 60, 12, 12, 1314022440, 60, 12, 12, 1314022500, 60, 12, 12, 1314022560, 60, 12, 12, 1314022620, 60, 12, 12, 1314022680, 60, 12, 12, 1314022740, 60, 12, 12, 1314022800, 60, 12, 12, 1314022860, 60, 12, 12, 1314022920, 60, 12, 12, 1314022980, 60, 12, 12, 1314023040, 60, 12, 12, 1314023100, 60, 12, 12, 1314023160, 60, 12, 12, 1314023220, 60, 12, 12, 1314023280, 60, 12, 12, 1314023340, 60, 12, 12, 1314023400, 60, 12, 12, 1314023460, 60, 12, 12, 1314023520, 60, 12, 12, 1314023580, 60, 12, 12, 1314023640, 60, 12, 12, 1314023700, 60, 12, 12, 1314023760, 60, 12, 12, 1314023820, 60, 12, 12, 1314023880, 60, 12, 12, 1314023940, 60, 12, 12, 1314024000, 60, 12, 12, 1314024060, 60, 12, 12, 1314024120, 60, 12, 12, 1314024180, 60, 12, 12, 1314024240, 60, 12, 12, 1314024300, 60, 12, 12, 1314024360, 60, 12, 12, 1314024420, 60, 12, 12, 1314024480, 60, 12, 12, 1314024540, 60, 12, 12, 1314024600, 60, 12, 12, 1314024660, 60, 12, 12, 1314024720, 60, 12, 12, 1314024780, 60, 12, 12, 1314024840, 60, 12, 12, 1314024900, 60, 12, 12, 1314024960, 60, 12, 12, 1314025020, 60, 12, 12, 1314025080, 60, 12, 12, 1314025140, 60, 12, 12, 1314025200, 60, 12, 12, 1314025260, 60, 12, 12, 1314025320, 60, 12, 12, 1314025380, 60, 12, 12, 1314025440, 60, 12, 12, 1314025500, 60, 12, 12, 1314025560, 60, 12, 12, 1314025620, 60, 12, 12, 1314025680, 60, 11, 11, 1314025740, 60, 12, 12, 1314025800, 60, 12, 12, 1314025860, 60, 12, 12, 1314025920, 60, 12, 12, 1314025980, 60, 12, 12, 1314026040, 60, 12, 12, 1314026100, 60, 12, 12, 1314026160, 60, 12, 12, 1314026220, 60, 12, 12, 1314026280, 60, 12, 12, 1314026340, 60, 12, 12, 1314026400, 60, 12, 12, 1314026460, 60, 12, 12, 1314026520, 60, 12, 12, 1314026580, 60, 12, 12, 1314026640, 60, 12, 12, 1314026700, 60, 12, 12, 1314026760, 60, 12, 12, 1314026820, 60, 12, 12, 1314026880, 60, 12, 12, 1314026940, 60, 12, 12, 1314027000, 60, 12, 12, 1314027060, 60, 12, 12, 1314027120, 60, 12, 12, 1314027180, 60, 12, 12, 1314027240, 60, 12, 12, 1314027300, 60, 12, 12, 1314027360, 60, 12, 12, 1314027420, 60, 12, 12, 1314027480, 60, 12, 12, 1314027540, 60, 12, 12, 1314027600, 60, 12, 12, 1314027660, 60, 12, 12, 1314027720, 60, 12, 12, 1314027780, 60, 12, 12, 1314027840, 60, 12, 12, 1314027900, 60, 12, 12, 1314027960, 60, 12, 12, 1314028020, 60, 12, 12, 1314028080, 60, 12, 12, 1314028140, 60, 12, 12, 1314028200, 60, 12, 12, 1314028260, 60, 12, 12, 1314028320, 60, 12, 12, 1314028380, 60, 12, 12, 1314028440, 60, 12, 12, 1314028500, 60, 12, 12, 1314028560, 60, 12, 12, 1314028620, 60, 12, 12, 1314028680, 60, 12, 12, 1314028740, 60, 12, 12, 1314028800, 60, 12, 12, 1314028860, 60, 12, 12, 1314028920, 60, 12, 12, 1314028980, 60, 12, 12, 1314029040, 60, 12, 12, 1314029100, 60, 12, 12, 1314029160, 60, 12, 12, 1314029220, 60, 12, 12, 1314029280, 60, 12, 12, 1314029340, 60, 12, 12, 1314029400, 60, 12, 12, 1314029460, 60, 12, 12, 1314029520, 60, 12, 12, 1314029580, 60, 12, 12, 1314029640, 60, 12, 12, 1314029700, 60, 12, 12, 1314029760, 60, 12, 12, 1314029820, 60, 12, 12, 1314029880, 60, 12, 12, 1314029940, 60, 12, 12, 1314030000, 60, 12, 12, 1314030060, 60, 12, 12, 1314030120, 60, 12, 12, 1314030180, 60, 12, 12, 1314030240, 60, 12, 12, 1314030300, 60, 12, 12, 1314030360, 60, 12, 12, 1314030420, 60, 12, 12, 1314030480, 60, 12, 12, 1314030540, 60, 12, 12, 1314030600, 60, 12, 12, 1314030660, 60, 12, 12, 1314030720, 60, 12, 12, 1314030780, 60, 11, 11, 1314030840, 60, 12, 12, 1314030900, 60, 12, 12, 1314030960, 60, 12, 12, 1314031020, 60, 12, 12, 1314031080, 60, 12, 12, 1314031140, 60, 12, 12, 1314031200, 60, 12, 12, 1314031260, 60, 12, 12, 1314031320, 60, 12, 12, 1314031380, 60, 12, 12, 1314031440, 60, 12, 12, 1314031500, 60, 12, 12, 1314031560, 60, 12, 12, 1314031620, 60, 12, 12, 1314031680, 60, 12, 12, 1314031740, 60, 12, 12, 1314031800, 60, 12, 12, 1314031860, 60, 12, 12, 1314031920, 60, 12, 12, 1314031980, 60, 12, 12, 1314032040, 60, 12, 12, 1314032100, 60, 12, 12, 1314032160, 60, 12, 12, 1314032220, 60, 12, 12, 1314032280, 60, 12, 12, 1314032340, 60, 12, 12, 1314032400, 60, 12, 12, 1314032460, 60, 12, 12, 1314032520, 60, 12, 12, 1314032580, 60, 12, 12, 1314032640, 60, 12, 12, 1314032700, 60, 12, 12, 1314032760, 60, 12, 12, 1314032820, 60, 12, 12, 1314032880, 60, 12, 12, 1314032940, 60, 12, 12, 1314033000, 60, 12, 12, 1314033060, 60, 12, 12, 1314033120, 60, 12, 12, 1314033180, 60, 12, 12, 1314033240, 60, 12, 12, 1314033300, 60, 12, 12, 1314033360, 60, 12, 12, 1314033420, 60, 12, 12, 1314033480, 60, 12, 12, 1314033540, 60, 12, 12, 1314033600, 60, 12, 12, 1314033660, 60, 12, 12, 1314033720, 60, 12, 12, 1314033780, 60, 12, 12, 1314033840, 60, 12, 12, 1314033900, 60, 12, 12, 1314033960, 60, 12, 12, 1314034020, 60, 12, 12, 1314034080, 60, 12, 12, 1314034140, 60, 12, 12, 1314034200, 60, 12, 12, 1314034260, 60, 12, 12, 1314034320, 60, 12, 12, 1314034380, 60, 12, 12, 1314034440, 60, 12, 12, 1314034500, 60, 12, 12, 1314034560, 60, 12, 12, 1314034620, 60, 12, 12, 1314034680, 60, 12, 12, 1314034740, 60, 12, 12, 1314034800, 60, 12, 12, 1314034860, 60, 12, 12, 1314034920, 60, 12, 12, 1314034980, 60, 12, 12, 1314035040, 60, 12, 12, 1314035100, 60, 12, 12, 1314035160, 60, 12, 12, 1314035220, 60, 12, 12, 1314035280, 60, 12, 12, 1314035340, 60, 12, 12, 1314035400, 60, 11, 11, 1314035460, 60, 12, 12, 1314035520, 60, 12, 12, 1314035580, 60, 12, 12, 1314035640, 60, 12, 12, 1314035700, 60, 12, 12, 1314035760, 60, 12, 12, 1314035820, 60, 12, 12, 1314035880, 60, 12, 12, 1314035940, 60, 12, 12, 1314036000, 60, 12, 12, 1314036060, 60, 12, 12, 1314036120, 60, 12, 12, 1314036180, 60, 12, 12, 1314036240, 60, 12, 12, 1314036300, 60, 12, 12, 1314036360, 60, 12, 12, 1314036420, 60, 12, 12, 1314036480, 60, 12, 12, 1314036540, 60, 12, 12, 1314036600, 60, 12, 12, 1314036660, 60, 12, 12, 1314036720, 60, 12, 12, 1314036780, 60, 12, 12, 1314036840, 60, 12, 12, 1314036900, 60, 12, 12, 1314036960, 60, 12, 12, 1314037020, 60, 12, 12, 1314037080, 60, 12, 12, 1314037140, 60, 12, 12, 1314037200, 60, 12, 12, 1314037260, 60, 12, 12, 1314037320, 60, 12, 12, 1314037380, 60, 12, 12, 1314037440, 60, 12, 12, 1314037500, 60, 12, 12, 1314037560, 60, 12, 12, 1314037620, 60, 12, 12, 1314037680, 60, 12, 12, 1314037740, 60, 12, 12, 1314037800, 60, 12, 12, 1314037860, 60, 12, 12, 1314037920, 60, 12, 12, 1314037980, 60, 12, 12, 1314038040, 60, 12, 12, 1314038100, 60, 12, 12, 1314038160, 60, 12, 12, 1314038220, 60, 12, 12, 1314038280, 60, 12, 12, 1314038340, 60, 12, 12, 1314038400, 60, 12, 12, 1314038460, 60, 12, 12, 1314038520, 60, 12, 12, 1314038580, 60, 12, 12, 1314038640, 60, 12, 12, 1314038700, 60, 12, 12, 1314038760, 60, 12, 12, 1314038820, 60, 12, 12, 1314038880, 60, 12, 12, 1314038940, 60, 12, 12, 1314039000, 60, 12, 12, 1314039060, 60, 12, 12, 1314039120, 60, 12, 12, 1314039180, 60, 12, 12, 1314039240, 60, 12, 12, 1314039300, 60, 12, 12, 1314039360, 60, 12, 12, 1314039420, 60, 12, 12, 1314039480, 60, 12, 12, 1314039540, 60, 12, 12, 1314039600, 60, 12, 12, 1314039660, 60, 12, 12, 1314039720, 60, 12, 12, 1314039780, 60, 12, 12, 1314039840, 60, 12, 12, 1314039900, 60, 12, 12, 1314039960, 60, 12, 12, 1314040020, 60, 12, 12, 1314040080, 60, 12, 12, 1314040140, 60, 12, 12, 1314040200, 60, 12, 12, 1314040260, 60, 12, 12, 1314040320, 60, 12, 12, 1314040380, 60, 11, 11, 1314040440, 60, 12, 12, 1314040500, 60, 12, 12, 1314040560, 60, 12, 12, 1314040620, 60, 12, 12, 1314040680, 60, 12, 12, 1314040740, 60, 12, 12, 1314040800, 60, 12, 12, 1314040860, 60, 12, 12, 1314040920, 60, 12, 12, 1314040980, 60, 12, 12, 1314041040, 60, 12, 12, 1314041100, 60, 12, 12, 1314041160, 60, 12, 12, 1314041220, 60, 12, 12, 1314041280, 60, 12, 12, 1314041340, 60, 12, 12, 1314041400, 60, 12, 12, 1314041460, 60, 12, 12, 1314041520, 60, 12, 12, 1314041580, 60, 12, 12, 1314041640, 60, 12, 12, 1314041700, 60, 12, 12, 1314041760, 60, 12, 12, 1314041820, 60, 12, 12, 1314041880, 60, 12, 12, 1314041940, 60, 12, 12, 1314042000, 60, 12, 12, 1314042060, 60, 12, 12, 1314042120, 60, 12, 12, 1314042180, 60, 12, 12, 1314042240, 60, 12, 12, 1314042300, 60, 12, 12, 1314042360, 60, 12, 12, 1314042420, 60, 12, 12, 1314042480, 60, 12, 12, 1314042540, 60, 12, 12, 1314042600, 60, 12, 12, 1314042660, 60, 12, 12, 1314042720, 60, 12, 12, 1314042780, 60, 12, 12, 1314042840, 60, 12, 12, 1314042900, 60, 12, 12, 1314042960, 60, 12, 12, 1314043020, 60, 12, 12, 1314043080, 60, 12, 12, 1314043140, 60, 12, 12, 1314043200, 60, 12, 12, 1314043260, 60, 12, 12, 1314043320, 60, 12, 12, 1314043380, 60, 12, 12, 1314043440, 60, 12, 12, 1314043500, 60, 12, 12, 1314043560, 60, 12, 12, 1314043620, 60, 12, 12, 1314043680, 60, 12, 12, 1314043740, 60, 12, 12, 1314043800, 60, 12, 12, 1314043860, 60, 12, 12, 1314043920, 60, 12, 12, 1314043980, 60, 12, 12, 1314044040, 60, 12, 12, 1314044100, 60, 12, 12, 1314044160, 60, 12, 12, 1314044220, 60, 12, 12, 1314044280, 60, 12, 12, 1314044340, 60, 12, 12, 1314044400, 60, 12, 12, 1314044460, 60, 12, 12, 1314044520, 60, 12, 12, 1314044580, 60, 12, 12, 1314044640, 60, 12, 12, 1314044700, 60, 12, 12, 1314044760, 60, 12, 12, 1314044820, 60, 12, 12, 1314044880, 60, 12, 12, 1314044940, 60, 12, 12, 1314045000, 60, 12, 12, 1314045060, 60, 12, 12, 1314045120, 60, 12, 12, 1314045180, 60, 12, 12, 1314045240, 60, 12, 12, 1314045300, 60, 12, 12, 1314045360, 60, 11, 11, 1314045420, 60, 12, 12, 1314045480, 60, 12, 12, 1314045540, 60, 12, 12, 1314045600, 60, 12, 12, 1314045660, 60, 12, 12, 1314045720, 60, 12, 12, 1314045780, 60, 12, 12, 1314045840, 60, 12, 12, 1314045900, 60, 12, 12, 1314045960, 60, 12, 12, 1314046020, 60, 12, 12, 1314046080, 60, 12, 12, 1314046140, 60, 12, 12, 1314046200, 60, 12, 12, 1314046260, 60, 12, 12, 1314046320, 60, 12, 12, 1314046380, 60, 12, 12, 1314046440, 60, 12, 12, 1314046500, 60, 12, 12, 1314046560, 60, 12, 12, 1314046620, 60, 12, 12, 1314046680, 60, 12, 12, 1314046740, 60, 12, 12, 1314046800, 60, 12, 12, 1314046860, 60, 12, 12, 1314046920, 60, 12, 12, 1314046980, 60, 12, 12, 1314047040, 60, 12, 12, 1314047100, 60, 12, 12, 1314047160, 60, 12, 12, 1314047220, 60, 12, 12, 1314047280, 60, 12, 12, 1314047340, 60, 12, 12, 1314047400, 60, 12, 12, 1314047460, 60, 12, 12, 1314047520, 60, 12, 12, 1314047580, 60, 12, 12, 1314047640, 60, 12, 12, 1314047700, 60, 12, 12, 1314047760, 60, 12, 12, 1314047820, 60, 12, 12, 1314047880, 60, 12, 12, 1314047940, 60, 12, 12, 1314048000, 60, 12, 12, 1314048060, 60, 12, 12, 1314048120, 60, 12, 12, 1314048180, 60, 12, 12, 1314048240, 60, 12, 12, 1314048300, 60, 12, 12, 1314048360, 60, 12, 12, 1314048420, 60, 12, 12, 1314048480, 60, 12, 12, 1314048540, 60, 12, 12, 1314048600, 60, 12, 12, 1314048660, 60, 12, 12, 1314048720, 60, 12, 12, 1314048780, 60, 12, 12, 1314048840, 60, 12, 12, 1314048900, 60, 12, 12, 1314048960, 60, 12, 12, 1314049020, 60, 12, 12, 1314049080, 60, 12, 12, 1314049140, 60, 12, 12, 1314049200, 60, 12, 12, 1314049260, 60, 12, 12, 1314049320, 60, 12, 12, 1314049380, 60, 12, 12, 1314049440, 60, 12, 12, 1314049500, 60, 12, 12, 1314049560, 60, 12, 12, 1314049620, 60, 12, 12, 1314049680, 60, 12, 12, 1314049740, 60, 11, 11, 1314049800, 60, 12, 12, 1314049860, 60, 12, 12, 1314049920, 60, 12, 12, 1314049980, 60, 12, 12, 1314050040, 60, 12, 12, 1314050100, 60, 12, 12, 1314050160, 60, 12, 12, 1314050220, 60, 12, 12, 1314050280, 60, 12, 12, 1314050340, 60, 12, 12, 1314050400, 60, 12, 12, 1314050460, 60, 12, 12, 1314050520, 60, 12, 12, 1314050580, 60, 12, 12, 1314050640, 60, 12, 12, 1314050700, 60, 12, 12, 1314050760, 60, 12, 12, 1314050820, 60, 12, 12, 1314050880, 60, 12, 12, 1314050940, 60, 12, 12, 1314051000, 60, 12, 12, 1314051060, 60, 12, 12, 1314051120, 60, 12, 12, 1314051180, 60, 12, 12, 1314051240, 60, 12, 12, 1314051300, 60, 12, 12, 1314051360, 60, 12, 12, 1314051420, 60, 12, 12, 1314051480, 60, 12, 12, 1314051540, 60, 12, 12, 1314051600, 60, 12, 12, 1314051660, 60, 12, 12, 1314051720, 60, 12, 12, 1314051780, 60, 12, 12, 1314051840, 60, 12, 12, 1314051900, 60, 12, 12, 1314051960, 60, 12, 12, 1314052020, 60, 12, 12, 1314052080, 60, 12, 12, 1314052140, 60, 12, 12, 1314052200, 60, 12, 12, 1314052260, 60, 12, 12, 1314052320, 60, 12, 12, 1314052380, 60, 12, 12, 1314052440, 60, 12, 12, 1314052500, 60, 12, 12, 1314052560, 60, 12, 12, 1314052620, 60, 12, 12, 1314052680, 60, 12, 12, 1314052740, 60, 12, 12, 1314052800, 60, 12, 12, 1314052860, 60, 12, 12, 1314052920, 60, 12, 12, 1314052980, 60, 12, 12, 1314053040, 60, 12, 12, 1314053100, 60, 12, 12, 1314053160, 60, 12, 12, 1314053220, 60, 12, 12, 1314053280, 60, 12, 12, 1314053340, 60, 12, 12, 1314053400, 60, 12, 12, 1314053460, 60, 12, 12, 1314053520, 60, 12, 12, 1314053580, 60, 12, 12, 1314053640, 60, 12, 12, 1314053700, 60, 12, 12, 1314053760, 60, 12, 12, 1314053820, 60, 17, 17, 1314053880, 60, 12, 12, 1314053940, 60, 12, 12, 1314054000, 60, 12, 12, 1314054060, 60, 12, 12, 1314054120, 60, 7, 7, 1314054180, 60, 6, 6, 1314054240, 60, 12, 12, 1314054300, 60, 17, 17, 1314054360, 60, 12, 12, 1314054420, 60, 12, 12, 1314054480, 60, 12, 12, 1314054540, 60, 22, 22, 1314054600, 60, 17, 17, 1314054660, 60, 12, 12, 1314054720, 60, 12, 12, 1314054780, 60, 17, 17, 1314054840, 60, 22, 22, 1314054900, 60, 12, 12, 1314054960, 60, 12, 12, 1314055020, 60, 12, 12, 1314055080, 60, 12, 12, 1314055140, 60, 10, 10, 1314055320, 60, 11, 11, 1314055380, 60, 12, 12, 1314055440, 60, 12, 12, 1314055500, 60, 12, 12, 1314055560, 60, 12, 12, 1314055620, 60, 12, 12, 1314055680, 60, 12, 12, 1314055740, 60, 12, 12, 1314055800, 60, 12, 12, 1314055860, 60, 12, 12, 1314055920, 60, 12, 12, 1314055980, 60, 12, 12, 1314056040, 60, 12, 12, 1314056100, 60, 12, 12, 1314056160, 60, 12, 12, 1314056220, 60, 12, 12, 1314056280, 60, 12, 12, 1314056340, 60, 12, 12, 1314056400, 60, 12, 12, 1314056460, 60, 12, 12, 1314056520, 60, 12, 12, 1314056580, 60, 12, 12, 1314056640, 60, 11, 11, 1314056700, 60, 12, 12, 1314056760, 60, 12, 12, 1314056820, 60, 12, 12, 1314056880, 60, 12, 12, 1314056940, 60, 12, 12, 1314057000, 60, 12, 12, 1314057060, 60, 12, 12, 1314057120, 60, 12, 12, 1314057180, 60, 12, 12, 1314057240, 60, 12, 12, 1314057300, 60, 12, 12, 1314057360, 60, 12, 12, 1314057420, 60, 12, 12, 1314057480, 60, 12, 12, 1314057540, 60, 12, 12, 1314057600, 60, 12, 12, 1314057660, 60, 12, 12, 1314057720, 60, 12, 12, 1314057780, 60, 12, 12, 1314057840, 60, 12, 12, 1314057900, 60, 12, 12, 1314057960, 60, 12, 12, 1314058020, 60, 12, 12, 1314058080, 60, 12, 12, 1314058140, 60, 12, 12, 1314058200, 60, 12, 12, 1314058260, 60, 12, 12, 1314058320, 60, 12, 12, 1314058380, 60, 12, 12, 1314058440, 60, 12, 12, 1314058500, 60, 12, 12, 1314058560, 60, 12, 12, 1314058620, 60, 12, 12, 1314058680, 60, 12, 12, 1314058740, 60, 12, 12, 1314058800, 60, 12, 12, 1314058860, 60, 12, 12, 1314058920, 60, 12, 12, 1314058980, 60, 12, 12, 1314059040, 60, 12, 12, 1314059100, 60, 12, 12, 1314059160, 60, 12, 12, 1314059220, 60, 12, 12, 1314059280, 60, 12, 12, 1314059340, 60, 12, 12, 1314059400, 60, 12, 12, 1314059460, 60, 12, 12, 1314059520, 60, 12, 12, 1314059580, 60, 12, 12, 1314059640, 60, 12, 12, 1314059700, 60, 12, 12, 1314059760, 60, 12, 12, 1314059820, 60, 12, 12, 1314059880, 60, 12, 12, 1314059940, 60, 12, 12, 1314060000, 60, 12, 12, 1314060060, 60, 12, 12, 1314060120, 60, 12, 12, 1314060180, 60, 12, 12, 1314060240, 60, 12, 12, 1314060300, 60, 12, 12, 1314060360, 60, 12, 12, 1314060420, 60, 12, 12, 1314060480, 60, 12, 12, 1314060540, 60, 12, 12, 1314060600, 60, 12, 12, 1314060660, 60, 12, 12, 1314060720, 60, 12, 12, 1314060780, 60, 12, 12, 1314060840, 60, 12, 12, 1314060900, 60, 12, 12, 1314060960, 60, 12, 12, 1314061020, 60, 12, 12, 1314061080, 60, 12, 12, 1314061140, 60, 12, 12, 1314061200, 60, 12, 12, 1314061260, 60, 12, 12, 1314061320, 60, 12, 12, 1314061380, 60, 12, 12, 1314061440, 60, 12, 12, 1314061500, 60, 12, 12, 1314061560, 60, 11, 11, 1314061620, 60, 12, 12, 1314061680, 60, 12, 12, 1314061740, 60, 12, 12, 1314061800, 60, 12, 12, 1314061860, 60, 12, 12, 1314061920, 60, 12, 12, 1314061980, 60, 12, 12, 1314062040, 60, 12, 12, 1314062100, 60, 12, 12, 1314062160, 60, 12, 12, 1314062220, 60, 12, 12, 1314062280, 60, 12, 12, 1314062340, 60, 12, 12, 1314062400, 60, 12, 12, 1314062460, 60, 12, 12, 1314062520, 60, 12, 12, 1314062580, 60, 12, 12, 1314062640, 60, 12, 12, 1314062700, 60, 12, 12, 1314062760, 60, 12, 12, 1314062820, 60, 12, 12, 1314062880, 60, 12, 12, 1314062940, 60, 12, 12, 1314063000, 60, 12, 12, 1314063060, 60, 12, 12, 1314063120, 60, 12, 12, 1314063180, 60, 12, 12, 1314063240, 60, 12, 12, 1314063300, 60, 12, 12, 1314063360, 60, 12, 12, 1314063420, 60, 12, 12, 1314063480, 60, 12, 12, 1314063540, 60, 12, 12, 1314063600, 60, 12, 12, 1314063660, 60, 12, 12, 1314063720, 60, 12, 12, 1314063780, 60, 12, 12, 1314063840, 60, 12, 12, 1314063900, 60, 12, 12, 1314063960, 60, 12, 12, 1314064020, 60, 12, 12, 1314064080, 60, 12, 12, 1314064140, 60, 12, 12, 1314064200, 60, 12, 12, 1314064260, 60, 12, 12, 1314064320, 60, 12, 12, 1314064380, 60, 12, 12, 1314064440, 60, 12, 12, 1314064500, 60, 12, 12, 1314064560, 60, 12, 12, 1314064620, 60, 12, 12, 1314064680, 60, 12, 12, 1314064740, 60, 12, 12, 1314064800, 60, 12, 12, 1314064860, 60, 12, 12, 1314064920, 60, 12, 12, 1314064980, 60, 12, 12, 1314065040, 60, 12, 12, 1314065100, 60, 12, 12, 1314065160, 60, 12, 12, 1314065220, 60, 12, 12, 1314065280, 60, 12, 12, 1314065340, 60, 12, 12, 1314065400, 60, 12, 12, 1314065460, 60, 12, 12, 1314065520, 60, 12, 12, 1314065580, 60, 12, 12, 1314065640, 60, 12, 12, 1314065700, 60, 12, 12, 1314065760, 60, 12, 12, 1314065820, 60, 12, 12, 1314065880, 60, 12, 12, 1314065940, 60, 12, 12, 1314066000, 60, 12, 12, 1314066060, 60, 12, 12, 1314066120, 60, 12, 12, 1314066180, 60, 12, 12, 1314066240, 60, 12, 12, 1314066300, 60, 12, 12, 1314066360, 60, 12, 12, 1314066420, 60, 12, 12, 1314066480, 60, 12, 12, 1314066540, 60, 11, 11, 1314066600, 60, 12, 12, 1314066660, 60, 12, 12, 1314066720, 60, 12, 12, 1314066780, 60, 12, 12, 1314066840, 60, 12, 12, 1314066900, 60, 12, 12, 1314066960, 60, 12, 12, 1314067020, 60, 12, 12, 1314067080, 60, 12, 12, 1314067140, 60, 12, 12, 1314067200, 60, 12, 12, 1314067260, 60, 12, 12, 1314067320, 60, 12, 12, 1314067380, 60, 12, 12, 1314067440, 60, 12, 12, 1314067500, 60, 12, 12, 1314067560, 60, 12, 12, 1314067620, 60, 12, 12, 1314067680, 60, 12, 12, 1314067740, 60, 12, 12, 1314067800, 60, 12, 12, 1314067860, 60, 12, 12, 1314067920, 60, 12, 12, 1314067980, 60, 12, 12, 1314068040, 60, 12, 12, 1314068100, 60, 12, 12, 1314068160, 60, 12, 12, 1314068220, 60, 12, 12, 1314068280, 60, 12, 12, 1314068340, 60, 12, 12, 1314068400, 60, 12, 12, 1314068460, 60, 12, 12, 1314068520, 60, 12, 12, 1314068580, 60, 12, 12, 1314068640, 60, 12, 12, 1314068700, 60, 12, 12, 1314068760, 60, 12, 12, 1314068820, 60, 12, 12, 1314068880, 60, 12, 12, 1314068940, 60, 12, 12, 1314069000, 60, 12, 12, 1314069060, 60, 12, 12, 1314069120, 60, 12, 12, 1314069180, 60, 12, 12, 1314069240, 60, 12, 12, 1314069300, 60, 12, 12, 1314069360, 60, 12, 12, 1314069420, 60, 12, 12, 1314069480, 60, 12, 12, 1314069540, 60, 12, 12, 1314069600, 60, 12, 12, 1314069660, 60, 12, 12, 1314069720, 60, 12, 12, 1314069780, 60, 12, 12, 1314069840, 60, 12, 12, 1314069900, 60, 12, 12, 1314069960, 60, 12, 12, 1314070020, 60, 12, 12, 1314070080, 60, 12, 12, 1314070140, 60, 12, 12, 1314070200, 60, 12, 12, 1314070260, 60, 12, 12, 1314070320, 60, 12, 12, 1314070380, 60, 12, 12, 1314070440, 60, 12, 12, 1314070500, 60, 12, 12, 1314070560, 60, 12, 12, 1314070620, 60, 12, 12, 1314070680, 60, 12, 12, 1314070740, 60, 12, 12, 1314070800, 60, 12, 12, 1314070860, 60, 12, 12, 1314070920, 60, 12, 12, 1314070980, 60, 12, 12, 1314071040, 60, 12, 12, 1314071100, 60, 12, 12, 1314071160, 60, 12, 12, 1314071220, 60, 12, 12, 1314071280, 60, 12, 12, 1314071340, 60, 12, 12, 1314071400, 60, 12, 12, 1314071460, 60, 12, 12, 1314071520, 60, 11, 11, 1314071580, 60, 12, 12, 1314071640, 60, 12, 12, 1314071700, 60, 12, 12, 1314071760, 60, 12, 12, 1314071820, 60, 12, 12, 1314071880, 60, 12, 12, 1314071940, 60, 12, 12, 1314072000, 60, 12, 12, 1314072060, 60, 12, 12, 1314072120, 60, 12, 12, 1314072180, 60, 12, 12, 1314072240, 60, 12, 12, 1314072300, 60, 12, 12, 1314072360, 60, 12, 12, 1314072420, 60, 12, 12, 1314072480, 60, 12, 12, 1314072540, 60, 12, 12, 1314072600, 60, 12, 12, 1314072660, 60, 12, 12, 1314072720, 60, 12, 12, 1314072780, 60, 12, 12, 1314072840, 60, 12, 12, 1314072900, 60, 12, 12, 1314072960, 60, 12, 12, 1314073020, 60, 12, 12, 1314073080, 60, 12, 12, 1314073140, 60, 12, 12, 1314073200, 60, 12, 12, 1314073260, 60, 12, 12, 1314073320, 60, 12, 12, 1314073380, 60, 12, 12, 1314073440, 60, 12, 12, 1314073500, 60, 12, 12, 1314073560, 60, 12, 12, 1314073620, 60, 12, 12, 1314073680, 60, 12, 12, 1314073740, 60, 12, 12, 1314073800, 60, 12, 12, 1314073860, 60, 12, 12, 1314073920, 60, 12, 12, 1314073980, 60, 12, 12, 1314074040, 60, 12, 12, 1314074100, 60, 12, 12, 1314074160, 60, 12, 12, 1314074220, 60, 12, 12, 1314074280, 60, 12, 12, 1314074340, 60, 12, 12, 1314074400, 60, 12, 12, 1314074460, 60, 12, 12, 1314074520, 60, 12, 12, 1314074580, 60, 12, 12, 1314074640, 60, 12, 12, 1314074700, 60, 12, 12, 1314074760, 60, 12, 12, 1314074820, 60, 12, 12, 1314074880, 60, 12, 12, 1314074940, 60, 12, 12, 1314075000, 60, 12, 12, 1314075060, 60, 12, 12, 1314075120, 60, 12, 12, 1314075180, 60, 12, 12, 1314075240, 60, 12, 12, 1314075300, 60, 12, 12, 1314075360, 60, 12, 12, 1314075420, 60, 12, 12, 1314075480, 60, 12, 12, 1314075540, 60, 12, 12, 1314075600, 60, 12, 12, 1314075660, 60, 12, 12, 1314075720, 60, 12, 12, 1314075780, 60, 12, 12, 1314075840, 60, 12, 12, 1314075900, 60, 12, 12, 1314075960, 60, 12, 12, 1314076020, 60, 12, 12, 1314076080, 60, 12, 12, 1314076140, 60, 12, 12, 1314076200, 60, 12, 12, 1314076260, 60, 12, 12, 1314076320, 60, 12, 12, 1314076380, 60, 12, 12, 1314076440, 60, 12, 12, 1314076500, 60, 11, 11, 1314076560, 60, 12, 12, 1314076620, 60, 12, 12, 1314076680, 60, 12, 12, 1314076740, 60, 12, 12, 1314076800, 60, 12, 12, 1314076860, 60, 12, 12, 1314076920, 60, 12, 12, 1314076980, 60, 12, 12, 1314077040, 60, 12, 12, 1314077100, 60, 12, 12, 1314077160, 60, 12, 12, 1314077220, 60, 12, 12, 1314077280, 60, 12, 12, 1314077340, 60, 12, 12, 1314077400, 60, 12, 12, 1314077460, 60, 12, 12, 1314077520, 60, 12, 12, 1314077580, 60, 12, 12, 1314077640, 60, 12, 12, 1314077700, 60, 12, 12, 1314077760, 60, 12, 12, 1314077820, 60, 12, 12, 1314077880, 60, 12, 12, 1314077940, 60, 12, 12, 1314078000, 60, 12, 12, 1314078060, 60, 12, 12, 1314078120, 60, 12, 12, 1314078180, 60, 12, 12, 1314078240, 60, 12, 12, 1314078300, 60, 12, 12, 1314078360, 60, 12, 12, 1314078420, 60, 12, 12, 1314078480, 60, 12, 12, 1314078540, 60, 12, 12, 1314078600, 60, 12, 12, 1314078660, 60, 12, 12, 1314078720, 60, 12, 12, 1314078780, 60, 12, 12, 1314078840, 60, 12, 12, 1314078900, 60, 12, 12, 1314078960, 60, 12, 12, 1314079020, 60, 12, 12, 1314079080, 60, 12, 12, 1314079140, 60, 12, 12, 1314079200, 60, 12, 12, 1314079260, 60, 12, 12, 1314079320, 60, 12, 12, 1314079380, 60, 12, 12, 1314079440, 60, 12, 12, 1314079500, 60, 12, 12, 1314079560, 60, 12, 12, 1314079620, 60, 12, 12, 1314079680, 60, 12, 12, 1314079740, 60, 12, 12, 1314079800, 60, 12, 12, 1314079860, 60, 12, 12, 1314079920, 60, 12, 12, 1314079980, 60, 12, 12, 1314080040, 60, 12, 12, 1314080100, 60, 12, 12, 1314080160, 60, 12, 12, 1314080220, 60, 12, 12, 1314080280, 60, 12, 12, 1314080340, 60, 12, 12, 1314080400, 60, 12, 12, 1314080460, 60, 12, 12, 1314080520, 60, 12, 12, 1314080580, 60, 12, 12, 1314080640, 60, 12, 12, 1314080700, 60, 12, 12, 1314080760, 60, 12, 12, 1314080820, 60, 12, 12, 1314080880, 60, 12, 12, 1314080940, 60, 12, 12, 1314081000, 60, 12, 12, 1314081060, 60, 12, 12, 1314081120, 60, 12, 12, 1314081180, 60, 12, 12, 1314081240, 60, 12, 12, 1314081300, 60, 12, 12, 1314081360, 60, 12, 12, 1314081420, 60, 11, 11, 1314081480, 60, 12, 12, 1314081540, 60, 12, 12, 1314081600, 60, 12, 12, 1314081660, 60, 12, 12, 1314081720, 60, 12, 12, 1314081780, 60, 12, 12, 1314081840, 60, 12, 12, 1314081900, 60, 12, 12, 1314081960, 60, 12, 12, 1314082020, 60, 12, 12, 1314082080, 60, 12, 12, 1314082140, 60, 12, 12, 1314082200, 60, 12, 12, 1314082260, 60, 12, 12, 1314082320, 60, 12, 12, 1314082380, 60, 12, 12, 1314082440, 60, 12, 12, 1314082500, 60, 12, 12, 1314082560, 60, 12, 12, 1314082620, 60, 12, 12, 1314082680, 60, 12, 12, 1314082740, 60, 12, 12, 1314082800, 60, 12, 12, 1314082860, 60, 12, 12, 1314082920, 60, 12, 12, 1314082980, 60, 12, 12, 1314083040, 60, 12, 12, 1314083100, 60, 12, 12, 1314083160, 60, 12, 12, 1314083220, 60, 12, 12, 1314083280, 60, 12, 12, 1314083340, 60, 12, 12, 1314083400, 60, 12, 12, 1314083460, 60, 12, 12, 1314083520, 60, 12, 12, 1314083580, 60, 12, 12, 1314083640, 60, 12, 12, 1314083700, 60, 12, 12, 1314083760, 60, 12, 12, 1314083820, 60, 12, 12, 1314083880, 60, 12, 12, 1314083940, 60, 12, 12, 1314084000, 60, 12, 12, 1314084060, 60, 12, 12, 1314084120, 60, 12, 12, 1314084180, 60, 12, 12, 1314084240, 60, 12, 12, 1314084300, 60, 12, 12, 1314084360, 60, 12, 12, 1314084420, 60, 12, 12, 1314084480, 60, 12, 12, 1314084540, 60, 12, 12, 1314084600, 60, 12, 12, 1314084660, 60, 12, 12, 1314084720, 60, 12, 12, 1314084780, 60, 12, 12, 1314084840, 60, 12, 12, 1314084900, 60, 12, 12, 1314084960, 60, 12, 12, 1314085020, 60, 12, 12, 1314085080, 60, 12, 12, 1314085140, 60, 12, 12, 1314085200, 60, 12, 12, 1314085260, 60, 12, 12, 1314085320, 60, 12, 12, 1314085380, 60, 12, 12, 1314085440, 60, 12, 12, 1314085500, 60, 12, 12, 1314085560, 60, 12, 12, 1314085620, 60, 12, 12, 1314085680, 60, 12, 12, 1314085740, 60, 12, 12, 1314085800, 60, 12, 12, 1314085860, 60, 12, 12, 1314085920, 60, 12, 12, 1314085980, 60, 12, 12, 1314086040, 60, 12, 12, 1314086100, 60, 12, 12, 1314086160, 60, 12, 12, 1314086220, 60, 12, 12, 1314086280, 60, 12, 12, 1314086340, 60, 12, 12, 1314086400, 60, 11, 11, 1314086460, 60, 12, 12, 1314086520, 60, 12, 12, 1314086580, 60, 12, 12, 1314086640, 60, 12, 12, 1314086700, 60, 12, 12, 1314086760, 60, 12, 12, 1314086820, 60, 12, 12, 1314086880, 60, 12, 12, 1314086940, 60, 12, 12, 1314087000, 60, 12, 12, 1314087060, 60, 12, 12, 1314087120, 60, 12, 12, 1314087180, 60, 12, 12, 1314087240, 60, 12, 12, 1314087300, 60, 12, 12, 1314087360, 60, 12, 12, 1314087420, 60, 12, 12, 1314087480, 60, 12, 12, 1314087540, 60, 12, 12, 1314087600, 60, 12, 12, 1314087660, 60, 12, 12, 1314087720, 60, 12, 12, 1314087780, 60, 12, 12, 1314087840, 60, 12, 12, 1314087900, 60, 12, 12, 1314087960, 60, 12, 12, 1314088020, 60, 12, 12, 1314088080, 60, 12, 12, 1314088140, 60, 12, 12, 1314088200, 60, 12, 12, 1314088260, 60, 12, 12, 1314088320, 60, 12, 12, 1314088380, 60, 12, 12, 1314088440, 60, 12, 12, 1314088500, 60, 12, 12, 1314088560, 60, 12, 12, 1314088620, 60, 12, 12, 1314088680, 60, 12, 12, 1314088740, 60, 12, 12, 1314088800, 60, 12, 12, 1314088860, 60, 12, 12, 1314088920, 60, 12, 12, 1314088980, 60, 12, 12, 1314089040, 60, 12, 12, 1314089100, 60, 12, 12, 1314089160, 60, 12, 12, 1314089220, 60, 12, 12, 1314089280, 60, 12, 12, 1314089340, 60, 12, 12, 1314089400, 60, 12, 12, 1314089460, 60, 12, 12, 1314089520, 60, 12, 12, 1314089580, 60, 12, 12, 1314089640, 60, 12, 12, 1314089700, 60, 12, 12, 1314089760, 60, 12, 12, 1314089820, 60, 12, 12, 1314089880, 60, 12, 12, 1314089940, 60, 12, 12, 1314090000, 60, 12, 12, 1314090060, 60, 12, 12, 1314090120, 60, 12, 12, 1314090180, 60, 12, 12, 1314090240, 60, 12, 12, 1314090300, 60, 12, 12, 1314090360, 60, 12, 12, 1314090420, 60, 12, 12, 1314090480, 60, 12, 12, 1314090540, 60, 12, 12, 1314090600, 60, 12, 12, 1314090660, 60, 12, 12, 1314090720, 60, 12, 12, 1314090780, 60, 12, 12, 1314090840, 60, 12, 12, 1314090900, 60, 12, 12, 1314090960, 60, 12, 12, 1314091020, 60, 12, 12, 1314091080, 60, 12, 12, 1314091140, 60, 12, 12, 1314091200, 60, 12, 12, 1314091260, 60, 12, 12, 1314091320, 60, 12, 12, 1314091380, 60, 11, 11, 1314091440, 60, 12, 12, 1314091500, 60, 12, 12, 1314091560, 60, 12, 12, 1314091620, 60, 12, 12, 1314091680, 60, 12, 12, 1314091740, 60, 12, 12, 1314091800, 60, 12, 12, 1314091860, 60, 12, 12, 1314091920, 60, 12, 12, 1314091980, 60, 12, 12, 1314092040, 60, 12, 12, 1314092100, 60, 12, 12, 1314092160, 60, 12, 12, 1314092220, 60, 12, 12, 1314092280, 60, 12, 12, 1314092340, 60, 12, 12, 1314092400, 60, 12, 12, 1314092460, 60, 12, 12, 1314092520, 60, 12, 12, 1314092580, 60, 12, 12, 1314092640, 60, 12, 12, 1314092700, 60, 12, 12, 1314092760, 60, 12, 12, 1314092820, 60, 12, 12, 1314092880, 60, 12, 12, 1314092940, 60, 12, 12, 1314093000, 60, 12, 12, 1314093060, 60, 12, 12, 1314093120, 60, 12, 12, 1314093180, 60, 12, 12, 1314093240, 60, 12, 12, 1314093300, 60, 12, 12, 1314093360, 60, 12, 12, 1314093420, 60, 12, 12, 1314093480, 60, 12, 12, 1314093540, 60, 12, 12, 1314093600, 60, 12, 12, 1314093660, 60, 12, 12, 1314093720, 60, 12, 12, 1314093780, 60, 12, 12, 1314093840, 60, 12, 12, 1314093900, 60, 12, 12, 1314093960, 60, 12, 12, 1314094020, 60, 12, 12, 1314094080, 60, 12, 12, 1314094140, 60, 12, 12, 1314094200, 60, 12, 12, 1314094260, 60, 12, 12, 1314094320, 60, 12, 12, 1314094380, 60, 12, 12, 1314094440, 60, 12, 12, 1314094500, 60, 12, 12, 1314094560, 60, 12, 12, 1314094620, 60, 12, 12, 1314094680, 60, 12, 12, 1314094740, 60, 12, 12, 1314094800, 60, 12, 12, 1314094860, 60, 12, 12, 1314094920, 60, 12, 12, 1314094980, 60, 12, 12, 1314095040, 60, 12, 12, 1314095100, 60, 12, 12, 1314095160, 60, 12, 12, 1314095220, 60, 12, 12, 1314095280, 60, 12, 12, 1314095340, 60, 12, 12, 1314095400, 60, 12, 12, 1314095460, 60, 12, 12, 1314095520, 60, 12, 12, 1314095580, 60, 12, 12, 1314095640, 60, 12, 12, 1314095700, 60, 12, 12, 1314095760, 60, 12, 12, 1314095820, 60, 12, 12, 1314095880, 60, 12, 12, 1314095940, 60, 12, 12, 1314096000, 60, 12, 12, 1314096060, 60, 12, 12, 1314096120, 60, 12, 12, 1314096180, 60, 12, 12, 1314096240, 60, 12, 12, 1314096300, 60, 11, 11, 1314096360, 60, 12, 12, 1314096420, 60, 12, 12, 1314096480, 60, 12, 12, 1314096540, 60, 12, 12, 1314096600, 60, 12, 12, 1314096660, 60, 12, 12, 1314096720, 60, 12, 12, 1314096780, 60, 12, 12, 1314096840, 60, 12, 12, 1314096900, 60, 12, 12, 1314096960, 60, 12, 12, 1314097020, 60, 12, 12, 1314097080, 60, 12, 12, 1314097140, 60, 12, 12, 1314097200, 60, 12, 12, 1314097260, 60, 12, 12, 1314097320, 60, 12, 12, 1314097380, 60, 12, 12, 1314097440, 60, 12, 12, 1314097500, 60, 12, 12, 1314097560, 60, 12, 12, 1314097620, 60, 12, 12, 1314097680, 60, 12, 12, 1314097740, 60, 12, 12, 1314097800, 60, 12, 12, 1314097860, 60, 12, 12, 1314097920, 60, 12, 12, 1314097980, 60, 12, 12, 1314098040, 60, 12, 12, 1314098100, 60, 12, 12, 1314098160, 60, 12, 12, 1314098220, 60, 12, 12, 1314098280, 60, 12, 12, 1314098340, 60, 12, 12, 1314098400, 60, 12, 12, 1314098460, 60, 12, 12, 1314098520, 60, 12, 12, 1314098580, 60, 12, 12, 1314098640, 60, 12, 12, 1314098700, 60, 12, 12, 1314098760, 60, 12, 12, 1314098820, 60, 12, 12, 1314098880, 60, 12, 12, 1314098940, 60, 12, 12, 1314099000, 60, 12, 12, 1314099060, 60, 12, 12, 1314099120, 60, 12, 12, 1314099180, 60, 12, 12, 1314099240, 60, 12, 12, 1314099300, 60, 12, 12, 1314099360, 60, 12, 12, 1314099420, 60, 12, 12, 1314099480, 60, 12, 12, 1314099540, 60, 12, 12, 1314099600, 60, 12, 12, 1314099660, 60, 12, 12, 1314099720, 60, 12, 12, 1314099780, 60, 12, 12, 1314099840, 60, 12, 12, 1314099900, 60, 12, 12, 1314099960, 60, 12, 12, 1314100020, 60, 12, 12, 1314100080, 60, 12, 12, 1314100140, 60, 12, 12, 1314100200, 60, 12, 12, 1314100260, 60, 12, 12, 1314100320, 60, 12, 12, 1314100380, 60, 12, 12, 1314100440, 60, 12, 12, 1314100500, 60, 12, 12, 1314100560, 60, 12, 12, 1314100620, 60, 12, 12, 1314100680, 60, 12, 12, 1314100740, 60, 12, 12, 1314100800, 60, 12, 12, 1314100860, 60, 12, 12, 1314100920, 60, 12, 12, 1314100980, 60, 12, 12, 1314101040, 60, 12, 12, 1314101100, 60, 12, 12, 1314101160, 60, 12, 12, 1314101220, 60, 12, 12, 1314101280, 60, 11, 11, 1314101340, 60, 12, 12, 1314101400, 60, 12, 12, 1314101460, 60, 12, 12, 1314101520, 60, 12, 12, 1314101580, 60, 12, 12, 1314101640, 60, 12, 12, 1314101700, 60, 12, 12, 1314101760, 60, 12, 12, 1314101820, 60, 12, 12, 1314101880, 60, 12, 12, 1314101940, 60, 12, 12, 1314102000, 60, 12, 12, 1314102060, 60, 12, 12, 1314102120, 60, 12, 12, 1314102180, 60, 12, 12, 1314102240, 60, 12, 12, 1314102300, 60, 12, 12, 1314102360, 60, 12, 12, 1314102420, 60, 12, 12, 1314102480, 60, 12, 12, 1314102540, 60, 12, 12, 1314102600, 60, 12, 12, 1314102660, 60, 12, 12, 1314102720, 60, 12, 12, 1314102780, 60, 12, 12, 1314102840, 60, 12, 12, 1314102900, 60, 12, 12, 1314102960, 60, 12, 12, 1314103020, 60, 12, 12, 1314103080, 60, 12, 12, 1314103140, 60, 12, 12, 1314103200, 60, 12, 12, 1314103260, 60, 12, 12, 1314103320, 60, 12, 12, 1314103380, 60, 12, 12, 1314103440, 60, 12, 12, 1314103500, 60, 12, 12, 1314103560, 60, 12, 12, 1314103620, 60, 12, 12, 1314103680, 60, 12, 12, 1314103740, 60, 12, 12, 1314103800, 60, 12, 12, 1314103860, 60, 12, 12, 1314103920, 60, 12, 12, 1314103980, 60, 12, 12, 1314104040, 60, 12, 12, 1314104100, 60, 12, 12, 1314104160, 60, 12, 12, 1314104220, 60, 12, 12, 1314104280, 60, 12, 12, 1314104340, 60, 12, 12, 1314104400, 60, 12, 12, 1314104460, 60, 12, 12, 1314104520, 60, 12, 12, 1314104580, 60, 12, 12, 1314104640, 60, 12, 12, 1314104700, 60, 12, 12, 1314104760, 60, 12, 12, 1314104820, 60, 12, 12, 1314104880, 60, 12, 12, 1314104940, 60, 12, 12, 1314105000, 60, 12, 12, 1314105060, 60, 12, 12, 1314105120, 60, 12, 12, 1314105180, 60, 12, 12, 1314105240, 60, 12, 12, 1314105300, 60, 12, 12, 1314105360, 60, 12, 12, 1314105420, 60, 12, 12, 1314105480, 60, 12, 12, 1314105540, 60, 12, 12, 1314105600, 60, 12, 12, 1314105660, 60, 12, 12, 1314105720, 60, 12, 12, 1314105780, 60, 12, 12, 1314105840, 60, 12, 12, 1314105900, 60, 12, 12, 1314105960, 60, 12, 12, 1314106020, 60, 12, 12, 1314106080, 60, 12, 12, 1314106140, 60, 12, 12, 1314106200, 60, 12, 12, 1314106260, 60, 11, 11, 1314106320, 60, 12, 12, 1314106380, 60, 12, 12, 1314106440, 60, 12, 12, 1314106500, 60, 12, 12, 1314106560, 60, 12, 12, 1314106620, 60, 12, 12, 1314106680, 60, 12, 12, 1314106740, 60, 12, 12, 1314106800, 60, 12, 12, 1314106860, 60, 12, 12, 1314106920, 60, 12, 12, 1314106980, 60, 12, 12, 1314107040, 60, 12, 12, 1314107100, 60, 12, 12, 1314107160, 60, 12, 12, 1314107220, 60, 12, 12, 1314107280, 60, 12, 12, 1314107340, 60, 12, 12, 1314107400, 60, 12, 12, 1314107460, 60, 12, 12, 1314107520, 60, 12, 12, 1314107580, 60, 12, 12, 1314107640, 60, 12, 12, 1314107700, 60, 12, 12, 1314107760, 60, 12, 12, 1314107820, 60, 12, 12, 1314107880, 60, 12, 12, 1314107940, 60, 12, 12, 1314108000, 60, 12, 12, 1314108060, 60, 12, 12, 1314108120, 60, 12, 12, 1314108180, 60, 12, 12, 1314108240, 60, 12, 12, 1314108300, 60, 12, 12, 1314108360, 60, 12, 12, 1314108420, 60, 12, 12, 1314108480, 60, 12, 12, 1314108540, 60, 12, 12, 1314108600, 60, 12, 12, 1314108660, 60, 12, 12, 1314108720, 60, 12, 12, 1314108780, 60, 12, 12, 1314108840, 60, 12, 12, 1314108900, 60, 12, 12, 1314108960, 60, 12, 12, 1314109020, 60, 12, 12, 1314109080, 60, 12, 12, 1314109140, 60, 12, 12, 1314109200, 60, 12, 12, 1314109260, 60, 12, 12, 1314109320, 60, 12, 12, 1314109380, 60, 12, 12, 1314109440, 60, 12, 12, 1314109500, 60, 12, 12, 1314109560, 60, 12, 12, 1314109620, 60, 12, 12, 1314109680, 60, 12, 12, 1314109740, 60, 12, 12, 1314109800, 60, 12, 12, 1314109860, 60, 12, 12, 1314109920, 60, 12, 12, 1314109980, 60, 12, 12, 1314110040, 60, 12, 12, 1314110100, 60, 12, 12, 1314110160, 60, 12, 12, 1314110220, 60, 12, 12, 1314110280, 60, 12, 12, 1314110340, 60, 12, 12, 1314110400, 60, 12, 12, 1314110460, 60, 12, 12, 1314110520, 60, 12, 12, 1314110580, 60, 12, 12, 1314110640, 60, 12, 12, 1314110700, 60, 12, 12, 1314110760, 60, 12, 12, 1314110820, 60, 12, 12, 1314110880, 60, 12, 12, 1314110940, 60, 12, 12, 1314111000, 60, 12, 12, 1314111060, 60, 12, 12, 1314111120, 60, 12, 12, 1314111180, 60, 12, 12, 1314111240, 60, 11, 11, 1314111300, 60, 12, 12, 1314111360, 60, 12, 12, 1314111420, 60, 12, 12, 1314111480, 60, 12, 12, 1314111540, 60, 12, 12, 1314111600, 60, 12, 12, 1314111660, 60, 12, 12, 1314111720, 60, 12, 12, 1314111780, 60, 12, 12, 1314111840, 60, 12, 12, 1314111900, 60, 12, 12, 1314111960, 60, 12, 12, 1314112020, 60, 12, 12, 1314112080, 60, 12, 12, 1314112140, 60, 12, 12, 1314112200, 60, 12, 12, 1314112260, 60, 12, 12, 1314112320, 60, 12, 12, 1314112380, 60, 12, 12, 1314112440, 60, 12, 12, 1314112500, 60, 12, 12, 1314112560, 60, 12, 12, 1314112620, 60, 12, 12, 1314112680, 60, 12, 12, 1314112740, 60, 12, 12, 1314112800, 60, 12, 12, 1314112860, 60, 12, 12, 1314112920, 60, 12, 12, 1314112980, 60, 12, 12, 1314113040, 60, 12, 12, 1314113100, 60, 12, 12, 1314113160, 60, 12, 12, 1314113220, 60, 12, 12, 1314113280, 60, 12, 12, 1314113340, 60, 12, 12, 1314113400, 60, 12, 12, 1314113460, 60, 12, 12, 1314113520, 60, 12, 12, 1314113580, 60, 12, 12, 1314113640, 60, 12, 12, 1314113700, 60, 12, 12, 1314113760, 60, 12, 12, 1314113820, 60, 12, 12, 1314113880, 60, 12, 12, 1314113940, 60, 12, 12, 1314114000, 60, 12, 12, 1314114060, 60, 12, 12, 1314114120, 60, 12, 12, 1314114180, 60, 12, 12, 1314114240, 60, 12, 12, 1314114300, 60, 12, 12, 1314114360, 60, 12, 12, 1314114420, 60, 12, 12, 1314114480, 60, 12, 12, 1314114540, 60, 12, 12, 1314114600, 60, 12, 12, 1314114660, 60, 12, 12, 1314114720, 60, 12, 12, 1314114780, 60, 12, 12, 1314114840, 60, 12, 12, 1314114900, 60, 12, 12, 1314114960, 60, 12, 12, 1314115020, 60, 12, 12, 1314115080, 60, 12, 12, 1314115140, 60, 12, 12, 1314115200, 60, 12, 12, 1314115260, 60, 12, 12, 1314115320, 60, 12, 12, 1314115380, 60, 12, 12, 1314115440, 60, 12, 12, 1314115500, 60, 12, 12, 1314115560, 60, 12, 12, 1314115620, 60, 12, 12, 1314115680, 60, 12, 12, 1314115740, 60, 12, 12, 1314115800, 60, 12, 12, 1314115860, 60, 12, 12, 1314115920, 60, 12, 12, 1314115980, 60, 12, 12, 1314116040, 60, 12, 12, 1314116100, 60, 12, 12, 1314116160, 60, 12, 12, 1314116220, 60, 11, 11, 1314116280, 60, 12, 12, 1314116340, 60, 12, 12, 1314116400, 60, 12, 12, 1314116460, 60, 12, 12, 1314116520, 60, 12, 12, 1314116580, 60, 12, 12, 1314116640, 60, 12, 12, 1314116700, 60, 12, 12, 1314116760, 60, 12, 12, 1314116820, 60, 12, 12, 1314116880, 60, 12, 12, 1314116940, 60, 12, 12, 1314117000, 60, 12, 12, 1314117060, 60, 12, 12, 1314117120, 60, 12, 12, 1314117180, 60, 12, 12, 1314117240, 60, 12, 12, 1314117300, 60, 12, 12, 1314117360, 60, 12, 12, 1314117420, 60, 12, 12, 1314117480, 60, 12, 12, 1314117540, 60, 12, 12, 1314117600, 60, 12, 12, 1314117660, 60, 12, 12, 1314117720, 60, 12, 12, 1314117780, 60, 12, 12, 1314117840, 60, 12, 12, 1314117900, 60, 12, 12, 1314117960, 60, 12, 12, 1314118020, 60, 12, 12, 1314118080, 60, 12, 12, 1314118140, 60, 12, 12, 1314118200, 60, 12, 12, 1314118260, 60, 12, 12, 1314118320, 60, 12, 12, 1314118380, 60, 12, 12, 1314118440, 60, 12, 12, 1314118500, 60, 12, 12, 1314118560, 60, 12, 12, 1314118620, 60, 12, 12, 1314118680, 60, 12, 12, 1314118740, 60, 12, 12, 1314118800, 60, 12, 12, 1314118860, 60, 12, 12, 1314118920, 60, 12, 12, 1314118980, 60, 12, 12, 1314119040, 60, 12, 12, 1314119100, 60, 12, 12, 1314119160, 60, 12, 12, 1314119220, 60, 12, 12, 1314119280, 60, 12, 12, 1314119340, 60, 12, 12, 1314119400, 60, 12, 12, 1314119460, 60, 12, 12, 1314119520, 60, 12, 12, 1314119580, 60, 12, 12, 1314119640, 60, 12, 12, 1314119700, 60, 12, 12, 1314119760, 60, 12, 12, 1314119820, 60, 12, 12, 1314119880, 60, 12, 12, 1314119940, 60, 12, 12, 1314120000, 60, 12, 12, 1314120060, 60, 12, 12, 1314120120, 60, 12, 12, 1314120180, 60, 12, 12, 1314120240, 60, 12, 12, 1314120300, 60, 12, 12, 1314120360, 60, 12, 12, 1314120420, 60, 12, 12, 1314120480, 60, 12, 12, 1314120540, 60, 12, 12, 1314120600, 60, 12, 12, 1314120660, 60, 12, 12, 1314120720, 60, 12, 12, 1314120780, 60, 12, 12, 1314120840, 60, 12, 12, 1314120900, 60, 12, 12, 1314120960, 60, 12, 12, 1314121020, 60, 12, 12, 1314121080, 60, 12, 12, 1314121140, 60, 12, 12, 1314121200, 60, 11, 11, 1314121260, 60, 12, 12, 1314121320, 60, 12, 12, 1314121380, 60, 12, 12, 1314121440, 60, 12, 12, 1314121500, 60, 12, 12, 1314121560, 60, 12, 12, 1314121620, 60, 12, 12, 1314121680, 60, 12, 12, 1314121740, 60, 12, 12, 1314121800, 60, 12, 12, 1314121860, 60, 12, 12, 1314121920, 60, 12, 12, 1314121980, 60, 12, 12, 1314122040, 60, 12, 12, 1314122100, 60, 12, 12, 1314122160, 60, 12, 12, 1314122220, 60, 12, 12, 1314122280, 60, 12, 12, 1314122340, 60, 12, 12, 1314122400, 60, 12, 12, 1314122460, 60, 12, 12, 1314122520, 60, 12, 12, 1314122580, 60, 12, 12, 1314122640, 60, 12, 12, 1314122700, 60, 12, 12, 1314122760, 60, 12, 12, 1314122820, 60, 12, 12, 1314122880, 60, 12, 12, 1314122940, 60, 12, 12, 1314123000, 60, 12, 12, 1314123060, 60, 12, 12, 1314123120, 60, 12, 12, 1314123180, 60, 12, 12, 1314123240, 60, 12, 12, 1314123300, 60, 12, 12, 1314123360, 60, 12, 12, 1314123420, 60, 12, 12, 1314123480, 60, 12, 12, 1314123540, 60, 12, 12, 1314123600, 60, 12, 12, 1314123660, 60, 12, 12, 1314123720, 60, 12, 12, 1314123780, 60, 12, 12, 1314123840, 60, 12, 12, 1314123900, 60, 12, 12, 1314123960, 60, 12, 12, 1314124020, 60, 12, 12, 1314124080, 60, 12, 12, 1314124140, 60, 12, 12, 1314124200, 60, 12, 12, 1314124260, 60, 12, 12, 1314124320, 60, 12, 12, 1314124380, 60, 12, 12, 1314124440, 60, 12, 12, 1314124500, 60, 12, 12, 1314124560, 60, 12, 12, 1314124620, 60, 12, 12, 1314124680, 60, 12, 12, 1314124740, 60, 12, 12, 1314124800, 60, 12, 12, 1314124860, 60, 12, 12, 1314124920, 60, 12, 12, 1314124980, 60, 12, 12, 1314125040, 60, 12, 12, 1314125100, 60, 12, 12, 1314125160, 60, 12, 12, 1314125220, 60, 12, 12, 1314125280, 60, 12, 12, 1314125340, 60, 12, 12, 1314125400, 60, 12, 12, 1314125460, 60, 12, 12, 1314125520, 60, 12, 12, 1314125580, 60, 12, 12, 1314125640, 60, 12, 12, 1314125700, 60, 12, 12, 1314125760, 60, 12, 12, 1314125820, 60, 12, 12, 1314125880, 60, 12, 12, 1314125940, 60, 12, 12, 1314126000, 60, 12, 12, 1314126060, 60, 12, 12, 1314126120, 60, 11, 11, 1314126180, 60, 12, 12, 1314126240, 60, 12, 12, 1314126300, 60, 12, 12, 1314126360, 60, 12, 12, 1314126420, 60, 12, 12, 1314126480, 60, 12, 12, 1314126540, 60, 12, 12, 1314126600, 60, 12, 12, 1314126660, 60, 12, 12, 1314126720, 60, 12, 12, 1314126780, 60, 12, 12, 1314126840, 60, 12, 12, 1314126900, 60, 12, 12, 1314126960, 60, 12, 12, 1314127020, 60, 12, 12, 1314127080, 60, 12, 12, 1314127140, 60, 12, 12, 1314127200, 60, 12, 12, 1314127260, 60, 12, 12, 1314127320, 60, 12, 12, 1314127380, 60, 12, 12, 1314127440, 60, 12, 12, 1314127500, 60, 11, 11, 1314127560, 60, 12, 12, 1314127620, 60, 12, 12, 1314127680, 60, 12, 12, 1314127740, 60, 12, 12, 1314127800, 60, 12, 12, 1314127860, 60, 12, 12, 1314127920, 60, 12, 12, 1314127980, 60, 12, 12, 1314128040, 60, 12, 12, 1314128100, 60, 12, 12, 1314128160, 60, 12, 12, 1314128220, 60, 12, 12, 1314128280, 60, 12, 12, 1314128340, 60, 12, 12, 1314128400, 60, 12, 12, 1314128460, 60, 12, 12, 1314128520, 60, 12, 12, 1314128580, 60, 12, 12, 1314128640, 60, 12, 12, 1314128700, 60, 12, 12, 1314128760, 60, 12, 12, 1314128820, 60, 12, 12, 1314128880, 60, 12, 12, 1314128940, 60, 12, 12, 1314129000, 60, 12, 12, 1314129060, 60, 12, 12, 1314129120, 60, 12, 12, 1314129180, 60, 12, 12, 1314129240, 60, 12, 12, 1314129300, 60, 12, 12, 1314129360, 60, 12, 12, 1314129420, 60, 12, 12, 1314129480, 60, 12, 12, 1314129540, 60, 12, 12, 1314129600, 60, 12, 12, 1314129660, 60, 12, 12, 1314129720, 60, 12, 12, 1314129780, 60, 12, 12, 1314129840, 60, 12, 12, 1314129900, 60, 12, 12, 1314129960, 60, 12, 12, 1314130020, 60, 12, 12, 1314130080, 60, 12, 12, 1314130140, 60, 12, 12, 1314130200, 60, 12, 12, 1314130260, 60, 12, 12, 1314130320, 60, 12, 12, 1314130380, 60, 12, 12, 1314130440, 60, 12, 12, 1314130500, 60, 12, 12, 1314130560, 60, 12, 12, 1314130620, 60, 12, 12, 1314130680, 60, 12, 12, 1314130740, 60, 12, 12, 1314130800, 60, 12, 12, 1314130860, 60, 12, 12, 1314130920, 60, 12, 12, 1314130980, 60, 12, 12, 1314131040, 60, 12, 12, 1314131100, 60, 12, 12, 1314131160, 60, 12, 12, 1314131220, 60, 12, 12, 1314131280, 60, 12, 12, 1314131340, 60, 12, 12, 1314131400, 60, 12, 12, 1314131460, 60, 12, 12, 1314131520, 60, 12, 12, 1314131580, 60, 12, 12, 1314131640, 60, 12, 12, 1314131700, 60, 12, 12, 1314131760, 60, 12, 12, 1314131820, 60, 12, 12, 1314131880, 60, 12, 12, 1314131940, 60, 12, 12, 1314132000, 60, 12, 12, 1314132060, 60, 12, 12, 1314132120, 60, 11, 11, 1314132180, 60, 12, 12, 1314132240, 60, 12, 12, 1314132300, 60, 12, 12, 1314132360, 60, 12, 12, 1314132420, 60, 12, 12, 1314132480, 60, 12, 12, 1314132540, 60, 12, 12, 1314132600, 60, 12, 12, 1314132660, 60, 12, 12, 1314132720, 60, 12, 12, 1314132780, 60, 12, 12, 1314132840, 60, 12, 12, 1314132900, 60, 12, 12, 1314132960, 60, 12, 12, 1314133020, 60, 12, 12, 1314133080, 60, 12, 12, 1314133140, 60, 12, 12, 1314133200, 60, 12, 12, 1314133260, 60, 12, 12, 1314133320, 60, 12, 12, 1314133380, 60, 12, 12, 1314133440, 60, 12, 12, 1314133500, 60, 12, 12, 1314133560, 60, 12, 12, 1314133620, 60, 12, 12, 1314133680, 60, 12, 12, 1314133740, 60, 12, 12, 1314133800, 60, 12, 12, 1314133860, 60, 12, 12, 1314133920, 60, 12, 12, 1314133980, 60, 12, 12, 1314134040, 60, 12, 12, 1314134100, 60, 12, 12, 1314134160, 60, 12, 12, 1314134220, 60, 12, 12, 1314134280, 60, 12, 12, 1314134340, 60, 12, 12, 1314134400, 60, 12, 12, 1314134460, 60, 12, 12, 1314134520, 60, 12, 12, 1314134580, 60, 12, 12, 1314134640, 60, 12, 12, 1314134700, 60, 12, 12, 1314134760, 60, 12, 12, 1314134820, 60, 12, 12, 1314134880, 60, 12, 12, 1314134940, 60, 12, 12, 1314135000, 60, 12, 12, 1314135060, 60, 12, 12, 1314135120, 60, 12, 12, 1314135180, 60, 12, 12, 1314135240, 60, 12, 12, 1314135300, 60, 12, 12, 1314135360, 60, 12, 12, 1314135420, 60, 12, 12, 1314135480, 60, 12, 12, 1314135540, 60, 12, 12, 1314135600, 60, 12, 12, 1314135660, 60, 12, 12, 1314135720, 60, 12, 12, 1314135780, 60, 12, 12, 1314135840, 60, 12, 12, 1314135900, 60, 12, 12, 1314135960, 60, 12, 12, 1314136020, 60, 12, 12, 1314136080, 60, 12, 12, 1314136140, 60, 12, 12, 1314136200, 60, 12, 12, 1314136260, 60, 12, 12, 1314136320, 60, 11, 11, 1314136380, 60, 12, 12, 1314136440, 60, 12, 12, 1314136500, 60, 12, 12, 1314136560, 60, 12, 12, 1314136620, 60, 12, 12, 1314136680, 60, 12, 12, 1314136740, 60, 12, 12, 1314136800, 60, 12, 12, 1314136860, 60, 12, 12, 1314136920, 60, 12, 12, 1314136980, 60, 12, 12, 1314137040, 60, 12, 12, 1314137100, 60, 12, 12, 1314137160, 60, 12, 12, 1314137220, 60, 12, 12, 1314137280, 60, 12, 12, 1314137340, 60, 12, 12, 1314137400, 60, 10, 10}
+
+func BenchmarkPackBigArray(b *testing.B) {
+	fmt.Printf("!!!benchmark testing!!!\n")
+	for i := 0; i < b.N; i++ {
+		b := new(bytes.Buffer)
+		Pack(b, big)
+	}
+}
+
+func BenchmarkAppendArray(b *testing.B) {
+	b.ReportAllocs()
+	var buf []byte
+	var value interface{} = a_to_pack
+	for i := 0; i < b.N; i++ {
+		buf, _ = AppendValue(buf[:0], value)
+	}
+}
+
+func BenchmarkAppendBigArray(b *testing.B) {
+	b.ReportAllocs()
+	var buf []byte
+	var value interface{} = big
+	for i := 0; i < b.N; i++ {
+		buf, _ = AppendValue(buf[:0], value)
+	}
+}
+
+type testNode struct {
+	Name string
+	Next *testNode
+}
+
+func TestPackCycles(t *testing.T) {
+	m := map[string]interface{}{"a": 1}
+	m["self"] = m
+	s := []interface{}{1, nil}
+	s[1] = s
+	n := &testNode{Name: "n"}
+	n.Next = &testNode{Name: "m", Next: n}
+	tests := []struct {
+		value interface{}
+		path  string
+	}{
+		{m, `["self"]`},
+		{s, `[1]`},
+		{map[string]interface{}{"x": []interface{}{m}}, `["x"][0]["self"]`},
+		{n, `.Next.Next`},
+		{[]*testNode{n}, `[0].Next.Next`},
+	}
+	for _, test := range tests {
+		_, err := Pack(new(bytes.Buffer), test.value)
+		e, ok := err.(*EncodeError)
+		if !ok || e.Err != ErrCycle || e.Path != test.path {
+			t.Errorf("%T: expected a cycle at %s, got %v", test.value, test.path, err)
+		}
+		_, err = EncodedSize(test.value)
+		if e, ok := err.(*EncodeError); !ok || e.Path != test.path {
+			t.Errorf("%T: EncodedSize expected a cycle at %s, got %v", test.value, test.path, err)
+		}
+		// AppendValue goes round a few times before noticing
+		_, err = AppendValue(nil, test.value)
+		if !errors.Is(err, ErrCycle) {
+			t.Errorf("%T: AppendValue expected a cycle, got %v", test.value, err)
+		}
+	}
+
+	var x interface{}
+	x = &x
+	_, err := Pack(new(bytes.Buffer), x)
+	if !errors.Is(err, ErrCycle) {
+		t.Errorf("expected a pointer cycle, got %v", err)
+	}
+
+	// values seen more than once, but not inside themselves, are fine,
+	// even deep down where cycles are looked for
+	shared := map[string]interface{}{"x": 1}
+	var deep interface{} = []interface{}{shared, shared}
+	for i := 0; i < 150; i++ {
+		deep = []interface{}{deep, shared}
+	}
+	_, err = Pack(new(bytes.Buffer), deep)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := new(bytes.Buffer)
+	_, err = Pack(b, []interface{}{shared, shared, map[string]interface{}{"y": shared}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, _, err := Unpack(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(v.([]interface{})) != 3 {
+		t.Errorf("unexpected %v", v)
+	}
+
+	err = fmt.Errorf("wrapped: %w", &EncodeError{Path: "[0]", Err: ErrCycle})
+	if !errors.Is(err, ErrCycle) {
+		t.Errorf("%v should wrap ErrCycle", err)
+	}
+}
+
+// packs itself as an array
+type testPair struct {
+	A, B int
+}
+
+func (p testPair) EncodeMsgpack(pw *PackWriter) (int, error) {
+	numBytes, err := pw.WriteArrayHeader(2)
+	if err != nil {
+		return numBytes, err
+	}
+	for _, v := range []int{p.A, p.B} {
+		n, err := pw.Encode(v)
+		numBytes += n
+		if err != nil {
+			return numBytes, err
+		}
+	}
+	return numBytes, nil
+}
+
+func TestPackMaxDepth(t *testing.T) {
+	var deep interface{} = "bottom"
+	for i := 0; i < 4; i++ {
+		deep = []interface{}{deep}
+	}
+
+	pw := NewPackWriter(new(bytes.Buffer))
+	pw.MaxDepth = 4
+	_, err := pw.Encode(deep)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pw.MaxDepth = 3
+	_, err = pw.Encode(map[string]interface{}{"a": deep})
+	e, ok := err.(*EncodeError)
+	if !ok || e.Path != `["a"][0][0]` {
+		t.Fatalf("expected a depth error at [\"a\"][0][0], got %v", err)
+	}
+	if le, ok := e.Err.(*LimitError); !ok || le.Limit != "depth" || le.Value != 4 {
+		t.Errorf("expected a depth *LimitError, got %v", e.Err)
+	}
+	if e.Error() != `mpack: depth 4 exceeds limit of 3 at ["a"][0][0]` {
+		t.Errorf("unexpected message %q", e.Error())
+	}
+
+	// structs count, pointers don't
+	pw.MaxDepth = 2
+	_, err = pw.Encode(&testNode{Next: &testNode{}})
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = pw.Encode(&testNode{Next: &testNode{Next: &testNode{}}})
+	if e, ok := err.(*EncodeError); !ok || e.Path != ".Next.Next" {
+		t.Errorf("expected a depth error at .Next.Next, got %v", err)
+	}
+	_, err = pw.EncodedSize(&testNode{Next: &testNode{Next: &testNode{}}})
+	if e, ok := err.(*EncodeError); !ok || e.Path != ".Next.Next" {
+		t.Errorf("EncodedSize expected a depth error at .Next.Next, got %v", err)
+	}
+
+	// Pack and EncodedSize agree about what is too deep, whichever path
+	// packs the inner container
+	values := []interface{}{
+		[][]int{{1}}, [][]int64{{1}}, []map[string]string{{"a": "b"}},
+		[][]interface{}{{1}}, map[string]interface{}{"a": []string{"b"}},
+		[]testPair{{1, 2}}, []RawMessage{{0x91, 0x01}}, []testMoney{1},
+	}
+	for _, max := range []int{1, 2} {
+		pw := NewPackWriter(new(bytes.Buffer))
+		pw.MaxDepth = max
+		for _, v := range values {
+			_, packErr := pw.Encode(v)
+			_, sizeErr := pw.EncodedSize(v)
+			if fmt.Sprint(packErr) != fmt.Sprint(sizeErr) {
+				t.Errorf("%T with MaxDepth %d: Pack gave %v, EncodedSize %v", v, max, packErr, sizeErr)
+			}
+			// a marshaler returning a number adds no nesting
+			_, scalar := v.([]testMoney)
+			if (packErr == nil) != (max == 2 || scalar) {
+				t.Errorf("%T with MaxDepth %d: unexpected %v", v, max, packErr)
+			}
+		}
+	}
+
+	// the writer is reusable after an error
+	pw.MaxDepth = 0
+	_, err = pw.Encode(deep)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAppendValueDeep(t *testing.T) {
+	var deep interface{} = 1
+	for i := 0; i < 150; i++ {
+		deep = []interface{}{deep}
+	}
+	b := new(bytes.Buffer)
+	_, err := Pack(b, deep)
+	if err != nil {
+		t.Fatal(err)
+	}
+	appended, err := AppendValue(nil, deep)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(appended, b.Bytes()) {
+		t.Error("AppendValue and Pack differ")
 	}
 }
@@ -0,0 +1,34 @@
+package mpack
+
+// limits for decoding untrusted input, where zero means no limit, and
+// other decoding options.
+type DecoderOptions struct {
+	// longest str, bin or ext payload
+	MaxRawLength int
+	// most elements in an array, or entries in a map
+	MaxArrayLength int
+	MaxMapLength   int
+	// deepest nesting of arrays and maps
+	MaxDepth int
+	// largest encoded size of a single top level value
+	MaxBytes int
+
+	// unpack empty str and bin values as nil, the way older versions did
+	EmptyRawAsNil bool
+	// unpack integers as int64 (or uint64 when they don't fit) and floats
+	// as float64, whatever width they had on the wire
+	NormalizeNumbers bool
+	// bin values unpacked by UnpackBytesOptions are slices of its input
+	// rather than copies, so the input must not be changed while they are
+	// in use
+	AliasBytes bool
+}
+
+// the limits used by the rpc server and client
+var DefaultDecoderOptions = DecoderOptions{
+	MaxRawLength:   64 << 20,
+	MaxArrayLength: 1 << 20,
+	MaxMapLength:   1 << 20,
+	MaxDepth:       100,
+	MaxBytes:       128 << 20,
+}
@@ -1,258 +1,491 @@
 package mpack
 
 import (
+	"bufio"
 	"encoding/binary"
-	"errors"
-	"fmt"
 	"io"
-	"log"
+	"math"
 	"reflect"
 )
 
-/*
+// a reader that can also read a byte at a time
 type FullReader interface {
 	io.ByteReader
 	io.Reader
 }
-*/
 
 type PackReader struct {
-	reader io.Reader
+	reader  FullReader
+	options DecoderOptions
+
+	// nesting depth and bytes read of the current top level value
+	depth    int
+	consumed int
+
+	// bytes read since the reader was created
+	offset int
+
+	// while capturing, everything read is also appended to capture
+	capturing bool
+	capture   []byte
 }
 
+// readers that aren't already a FullReader get buffered, which means the
+// PackReader can read past the end of the current value.  Use a single
+// PackReader to read a stream of values.
 func NewPackReader(r io.Reader) *PackReader {
+	return NewPackReaderOptions(r, DecoderOptions{})
+}
+
+// like NewPackReader, but values exceeding the limits in options are
+// rejected with a *LimitError
+func NewPackReaderOptions(r io.Reader, options DecoderOptions) *PackReader {
 	result := new(PackReader)
-	result.reader = r
+	if fr, ok := r.(FullReader); ok {
+		result.reader = fr
+	} else {
+		result.reader = bufio.NewReader(r)
+	}
+	result.options = options
 	return result
 }
 
-func (pr PackReader) ReadByte() (byte, error) {
-	// return pr.reader.ReadByte()
-	data := [1]byte{}
-	n, err := pr.reader.Read(data[0:])
+// whether the next value is nil, if the reader can look ahead.  ok is
+// false if it can't, or there is nothing left to read.
+func (pr *PackReader) nextIsNil() (isNil, ok bool) {
+	rs, ok := pr.reader.(io.ByteScanner)
+	if !ok {
+		return false, false
+	}
+	b, err := rs.ReadByte()
 	if err != nil {
-		log.Printf("packreader ReadByte got error (%d bytes): %s", n, err)
-		return 0, err
+		return false, false
+	}
+	if rs.UnreadByte() != nil {
+		return false, false
+	}
+	return b == type_nil, true
+}
+
+func (pr *PackReader) ReadByte() (byte, error) {
+	b, err := pr.reader.ReadByte()
+	if err == nil {
+		pr.consumed++
+		pr.offset++
+		if pr.capturing {
+			pr.capture = append(pr.capture, b)
+		}
+		err = pr.checkConsumed()
+	}
+	return b, err
+}
+
+func (pr *PackReader) Read(p []byte) (int, error) {
+	// reading stops at MaxBytes.  io.ReadFull ignores the error from a
+	// read that fills p, so going over has to come back short.
+	capped := false
+	if left := pr.options.MaxBytes - pr.consumed; pr.options.MaxBytes > 0 && len(p) > left {
+		p = p[:max(left, 0)]
+		capped = true
+	}
+	n, err := pr.reader.Read(p)
+	pr.consumed += n
+	pr.offset += n
+	if pr.capturing {
+		pr.capture = append(pr.capture, p[:n]...)
+	}
+	if err == nil && capped && n == len(p) {
+		err = &LimitError{Limit: "bytes", Max: pr.options.MaxBytes, Value: uint64(pr.consumed) + 1}
+	}
+	return n, err
+}
+
+// the exact bytes of the next value
+func (pr *PackReader) readRawValue() ([]byte, error) {
+	pr.capturing = true
+	pr.capture = nil
+	err := pr.Skip()
+	raw := pr.capture
+	pr.capturing = false
+	pr.capture = nil
+	return raw, err
+}
+
+// ReadRawMessage reads the next value without decoding it
+func (pr *PackReader) ReadRawMessage() (RawMessage, error) {
+	raw, err := pr.readRawValue()
+	if err != nil {
+		return nil, err
+	}
+	return RawMessage(raw), nil
+}
+
+func (pr *PackReader) ReadBinary(result interface{}) error {
+	return binary.Read(pr, binary.BigEndian, result)
+}
+
+// check a length prefix before anything is allocated for it.  every
+// element takes at least one byte, so the length also has to fit in
+// what is left of MaxBytes.
+func (pr *PackReader) checkLength(limit string, length uint32, max int) error {
+	if max > 0 && uint64(length) > uint64(max) {
+		return &LimitError{Limit: limit, Max: max, Value: uint64(length)}
+	}
+	if pr.options.MaxBytes > 0 && uint64(pr.consumed)+uint64(length) > uint64(pr.options.MaxBytes) {
+		return &LimitError{Limit: "bytes", Max: pr.options.MaxBytes, Value: uint64(pr.consumed) + uint64(length)}
+	}
+	return nil
+}
+
+// fixed width values have no length prefix for checkLength to see, so
+// MaxBytes is also checked against every byte read
+func (pr *PackReader) checkConsumed() error {
+	if pr.options.MaxBytes > 0 && pr.consumed > pr.options.MaxBytes {
+		return &LimitError{Limit: "bytes", Max: pr.options.MaxBytes, Value: uint64(pr.consumed)}
+	}
+	return nil
+}
+
+// most elements allocated for an array before they have been read.
+// checkLength only compares a length with what is left of MaxBytes, which
+// every header inside an array can claim again, so beyond this slices
+// grow as their elements turn up.
+const preallocLimit = 1024
+
+func preallocLength(length int) int {
+	if length > preallocLimit {
+		return preallocLimit
+	}
+	return length
+}
+
+func (pr *PackReader) enter() error {
+	pr.depth++
+	if pr.options.MaxDepth > 0 && pr.depth > pr.options.MaxDepth {
+		return &LimitError{Limit: "depth", Max: pr.options.MaxDepth, Value: uint64(pr.depth)}
 	}
-	if n != 1 {
-		return 0, errors.New("didn't read just one byte")
+	return nil
+}
+
+func (pr *PackReader) leave() {
+	pr.depth--
+}
+
+// Enter and Leave bracket an array or map read with the Read methods, so
+// that decoders count it towards MaxDepth.  Leave isn't needed if Enter
+// fails, or once a decoder called by Decode has returned an error.
+func (pr *PackReader) Enter() error {
+	err := pr.enter()
+	if err != nil {
+		pr.leave()
 	}
-	return data[0], nil
+	return err
 }
 
-func (pr PackReader) ReadBinary(result interface{}) error {
-	return binary.Read(pr.reader, binary.BigEndian, result)
+func (pr *PackReader) Leave() {
+	pr.leave()
 }
 
-func (pr PackReader) unpackRaw(length uint32, prefixBytes int) (interface{}, int, error) {
+// an empty payload comes back as nil only with the EmptyRawAsNil option.
+// if alias is set and the reader is a byte slice, the payload is a slice
+// of it rather than a copy.
+func (pr *PackReader) readRaw(length int, alias bool) ([]byte, error) {
 	if length == 0 {
-		// lenght == 0 => nil...
-		return nil, 0, nil
+		if pr.options.EmptyRawAsNil {
+			return nil, nil
+		}
+		return []byte{}, nil
+	}
+	if sr, ok := pr.reader.(*sliceReader); ok && alias && !pr.capturing {
+		data, err := sr.next(length)
+		pr.consumed += len(data)
+		pr.offset += len(data)
+		return data, err
 	}
-	numRead := prefixBytes
 	data := make([]byte, length)
-	n, err := pr.reader.Read(data)
-	numRead += n
+	_, err := io.ReadFull(pr, data)
 	if err != nil {
-		return nil, numRead, err
+		return nil, err
 	}
-	return data, numRead, nil
+	return data, nil
 }
 
-func (pr PackReader) unpackArray(length uint32, prefixBytes int) (interface{}, int, error) {
-	numRead := prefixBytes
-	data := make([]interface{}, length)
-	for i := uint32(0); i < length; i++ {
-		elt, n, err := pr.unpack()
-		numRead += n
+func (pr *PackReader) unpackArray(length int) (interface{}, error) {
+	err := pr.enter()
+	defer pr.leave()
+	if err != nil {
+		return nil, err
+	}
+	data := make([]interface{}, 0, preallocLength(length))
+	for i := 0; i < length; i++ {
+		elt, err := pr.unpackValue()
 		if err != nil {
-			return nil, numRead, err
+			return nil, err
 		}
-		data[i] = elt
+		data = append(data, elt)
 	}
-	return data, numRead, nil
+	return data, nil
 }
 
-func (pr PackReader) unpackMap(length uint32, prefixBytes int) (interface{}, int, error) {
-	numRead := prefixBytes
+func (pr *PackReader) unpackMap(length int) (interface{}, error) {
+	err := pr.enter()
+	defer pr.leave()
+	if err != nil {
+		return nil, err
+	}
 
 	m := make(map[interface{}]interface{})
 
-	for i := uint32(0); i < length; i++ {
-		key, n, err := pr.unpack()
-		numRead += n
+	for i := 0; i < length; i++ {
+		offset := pr.offset
+		key, err := pr.unpackValue()
 		if err != nil {
-			return nil, numRead, err
+			return nil, err
+		}
+		if key != nil && !reflect.ValueOf(key).Comparable() {
+			return nil, &UnhashableKeyError{reflect.TypeOf(key), offset}
 		}
 
-		val, n, err := pr.unpack()
-		numRead += n
+		val, err := pr.unpackValue()
 		if err != nil {
-			return nil, numRead, err
+			return nil, err
 		}
 
-		if reflect.TypeOf(key).String() == "[]uint8" {
-			m[string(key.([]uint8))] = val
+		if raw, ok := key.([]uint8); ok {
+			m[string(raw)] = val
 		} else {
 			m[key] = val
 		}
 	}
 
-	return m, numRead, nil
+	return m, nil
 }
 
-func (pr PackReader) unpack() (interface{}, int, error) {
-	numRead := 0
-	b, err := pr.ReadByte()
-	if err != nil {
-		return nil, numRead, err
+// once part of a value has been read, running out of data is unexpected
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
 	}
-	numRead += 1
+	return err
+}
 
-	// how is this possible?
-	if b < 0 {
-		return nil, numRead, nil
-	}
+// unpack returns io.EOF only if the reader is exhausted before the value
+// starts, and io.ErrUnexpectedEOF if it is truncated.
+func (pr *PackReader) unpack() (interface{}, int, error) {
+	start := pr.offset
+	value, err := pr.unpackValue()
+	return value, pr.offset - start, err
+}
 
-	if b < positive_fix_max {
-		return b, numRead, nil
+func (pr *PackReader) unpackValue() (interface{}, error) {
+	tok, err := pr.NextToken()
+	if err != nil {
+		return nil, err
 	}
-	if b >= negative_fix_min && b <= negative_fix_max {
-		return (b & negative_fix_mask) - negative_fix_offset, numRead, nil
+	switch tok.Kind {
+	case ArrayToken:
+		value, err := pr.unpackArray(tok.Len)
+		return value, unexpectedEOF(err)
+	case MapToken:
+		value, err := pr.unpackMap(tok.Len)
+		return value, unexpectedEOF(err)
 	}
+	return tok.Value, nil
+}
 
-	if b >= type_fix_raw && b <= type_fix_raw_max {
-		return pr.unpackRaw(uint32(b&fix_raw_count_mask), numRead)
+// a FullReader over a byte slice that can hand out slices of it
+type sliceReader struct {
+	data []byte
+	pos  int
+}
+
+func (sr *sliceReader) ReadByte() (byte, error) {
+	if sr.pos >= len(sr.data) {
+		return 0, io.EOF
 	}
+	b := sr.data[sr.pos]
+	sr.pos++
+	return b, nil
+}
 
-	if b >= type_fix_array_min && b <= type_fix_array_max {
-		return pr.unpackArray(uint32(b&fix_array_count_mask), numRead)
+func (sr *sliceReader) Read(p []byte) (int, error) {
+	if sr.pos >= len(sr.data) && len(p) > 0 {
+		return 0, io.EOF
 	}
+	n := copy(p, sr.data[sr.pos:])
+	sr.pos += n
+	return n, nil
+}
 
-	if b >= type_fix_map_min && b <= type_fix_map_max {
-		return pr.unpackMap(uint32(b&fix_map_count_mask), numRead)
+// the next n bytes, or io.ErrUnexpectedEOF if there aren't that many
+func (sr *sliceReader) next(n int) ([]byte, error) {
+	if len(sr.data)-sr.pos < n {
+		sr.pos = len(sr.data)
+		return nil, io.ErrUnexpectedEOF
 	}
+	b := sr.data[sr.pos : sr.pos+n : sr.pos+n]
+	sr.pos += n
+	return b, nil
+}
 
-	switch b {
-	case type_nil:
-		return nil, numRead, nil
-	case type_false:
-		return false, numRead, nil
-	case type_true:
-		return true, numRead, nil
-	case type_uint8:
-		c, err := pr.ReadByte()
-		if err != nil {
-			return nil, numRead + 1, err
-		}
-		return uint8(c), numRead + 1, nil
-	case type_uint16:
-		var result uint16
-		err := pr.ReadBinary(&result)
-		if err != nil {
-			return nil, numRead + 2, err
-		}
-		return result, numRead + 2, nil
-	case type_uint32:
-		var result uint32
-		err := pr.ReadBinary(&result)
-		if err != nil {
-			return nil, numRead + 4, err
-		}
-		return result, numRead + 4, nil
-	case type_uint64:
-		var result uint64
-		err := pr.ReadBinary(&result)
-		if err != nil {
-			return nil, numRead + 8, err
-		}
-		return result, numRead + 8, nil
-	case type_int16:
-		var result int16
-		err := pr.ReadBinary(&result)
-		if err != nil {
-			return nil, numRead + 2, err
-		}
-		return result, numRead + 2, nil
-	case type_int32:
-		var result int32
-		err := pr.ReadBinary(&result)
-		if err != nil {
-			return nil, numRead + 4, err
-		}
-		return result, numRead + 4, nil
-	case type_int64:
-		var result int64
-		err := pr.ReadBinary(&result)
-		if err != nil {
-			return nil, numRead + 8, err
-		}
-		return result, numRead + 8, nil
-	case type_float:
-		var result float32
-		err := pr.ReadBinary(&result)
-		if err != nil {
-			return nil, numRead + 4, err
-		}
-		return result, numRead + 4, nil
-	case type_double:
-		var result float64
-		err := pr.ReadBinary(&result)
-		if err != nil {
-			return nil, numRead + 8, err
-		}
-		return result, numRead + 8, nil
-	case type_raw16:
-		var length uint16
-		err := pr.ReadBinary(&length)
-		numRead += 2
-		if err != nil {
-			return nil, numRead, err
-		}
-		return pr.unpackRaw(uint32(length), numRead)
-	case type_raw32:
-		var length uint32
-		err := pr.ReadBinary(&length)
-		numRead += 4
-		if err != nil {
-			return nil, numRead, err
-		}
-		return pr.unpackRaw(length, numRead)
-	case type_array16:
-		var length uint16
-		err := pr.ReadBinary(&length)
-		numRead += 2
-		if err != nil {
-			return nil, numRead, err
-		}
-		return pr.unpackArray(uint32(length), numRead)
-	case type_array32:
-		var length uint32
-		err := pr.ReadBinary(&length)
-		numRead += 2
-		if err != nil {
-			return nil, numRead, err
-		}
-		return pr.unpackArray(length, numRead)
-	case type_map16:
-		var length uint16
-		err := pr.ReadBinary(&length)
-		numRead += 2
-		if err != nil {
-			return nil, numRead, err
+// the Read methods are the counterparts of the PackWriter Write methods,
+// for reading values whose type is known.  A value of another type gives
+// an *UnmarshalTypeError.  nil reads as the zero value, or as a length of
+// -1 from ReadArrayHeader and ReadMapHeader.
+
+func (pr *PackReader) readLengthOf(kind TokenKind) (int, error) {
+	tok, err := pr.NextToken()
+	if err != nil {
+		return 0, err
+	}
+	switch tok.Kind {
+	case kind:
+		return tok.Len, nil
+	case NilToken:
+		return -1, nil
+	}
+	return 0, tokenTypeError(tok, reflect.TypeOf(0))
+}
+
+func (pr *PackReader) ReadArrayHeader() (int, error) {
+	return pr.readLengthOf(ArrayToken)
+}
+
+func (pr *PackReader) ReadMapHeader() (int, error) {
+	return pr.readLengthOf(MapToken)
+}
+
+func (pr *PackReader) ReadString() (string, error) {
+	tok, err := pr.NextToken()
+	if err != nil {
+		return "", err
+	}
+	switch s := tok.Value.(type) {
+	case string:
+		return s, nil
+	case []byte:
+		return string(s), nil
+	case nil:
+		if tok.Kind == NilToken || tok.Kind == StringToken || tok.Kind == BinToken {
+			return "", nil
 		}
-		return pr.unpackMap(uint32(length), numRead)
-	case type_map32:
-		var length uint32
-		err := pr.ReadBinary(&length)
-		numRead += 4
-		if err != nil {
-			return nil, numRead, err
+	}
+	return "", tokenTypeError(tok, reflect.TypeOf(""))
+}
+
+func (pr *PackReader) ReadBytes() ([]byte, error) {
+	tok, err := pr.NextToken()
+	if err != nil {
+		return nil, err
+	}
+	switch b := tok.Value.(type) {
+	case []byte:
+		return b, nil
+	case string:
+		return []byte(b), nil
+	case nil:
+		if tok.Kind == NilToken || tok.Kind == StringToken || tok.Kind == BinToken {
+			return nil, nil
 		}
-		return pr.unpackMap(length, numRead)
-	default:
-		fmt.Printf("unhandled type prefix: %x\n", b)
 	}
+	return nil, tokenTypeError(tok, reflect.TypeOf([]byte(nil)))
+}
+
+func (pr *PackReader) ReadInt() (int64, error) {
+	tok, err := pr.NextToken()
+	if err != nil || tok.Kind == NilToken {
+		return 0, err
+	}
+	if n, ok := tokenInt64(tok.Value); ok {
+		return n, nil
+	}
+	return 0, tokenTypeError(tok, reflect.TypeOf(int64(0)))
+}
+
+func (pr *PackReader) ReadUint() (uint64, error) {
+	tok, err := pr.NextToken()
+	if err != nil || tok.Kind == NilToken {
+		return 0, err
+	}
+	if n, ok := tokenUint64(tok.Value); ok {
+		return n, nil
+	}
+	return 0, tokenTypeError(tok, reflect.TypeOf(uint64(0)))
+}
+
+func (pr *PackReader) ReadFloat() (float64, error) {
+	tok, err := pr.NextToken()
+	if err != nil || tok.Kind == NilToken {
+		return 0, err
+	}
+	if f, ok := tokenFloat64(tok.Value); ok {
+		return f, nil
+	}
+	if n, ok := tokenInt64(tok.Value); ok {
+		return float64(n), nil
+	}
+	if n, ok := tokenUint64(tok.Value); ok {
+		return float64(n), nil
+	}
+	return 0, tokenTypeError(tok, reflect.TypeOf(float64(0)))
+}
+
+func (pr *PackReader) ReadBool() (bool, error) {
+	tok, err := pr.NextToken()
+	if err != nil || tok.Kind == NilToken {
+		return false, err
+	}
+	if b, ok := tok.Value.(bool); ok {
+		return b, nil
+	}
+	return false, tokenTypeError(tok, reflect.TypeOf(false))
+}
 
-	return b, numRead, nil
+func tokenTypeError(tok Token, t reflect.Type) error {
+	switch tok.Kind {
+	case ArrayToken, MapToken:
+		return &UnmarshalTypeError{Value: tok.Kind.String(), Type: t}
+	}
+	return typeError(tok.Value, t)
+}
+
+// a signed value of any width, or an unsigned one that fits
+func tokenInt64(generic interface{}) (int64, bool) {
+	switch n := generic.(type) {
+	case int8:
+		return int64(n), true
+	case int16:
+		return int64(n), true
+	case int32:
+		return int64(n), true
+	case int64:
+		return n, true
+	}
+	if n, ok := tokenUint64(generic); ok && n <= math.MaxInt64 {
+		return int64(n), true
+	}
+	return 0, false
+}
+
+// an unsigned value of any width, or a signed one that isn't negative
+func tokenUint64(generic interface{}) (uint64, bool) {
+	switch n := generic.(type) {
+	case uint8:
+		return uint64(n), true
+	case uint16:
+		return uint64(n), true
+	case uint32:
+		return uint64(n), true
+	case uint64:
+		return n, true
+	case int8:
+		return uint64(n), n >= 0
+	case int16:
+		return uint64(n), n >= 0
+	case int32:
+		return uint64(n), n >= 0
+	case int64:
+		return uint64(n), n >= 0
+	}
+	return 0, false
 }
@@ -1,14 +1,216 @@
 package mpack
 
 import (
+	"bytes"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
 	"reflect"
+	"sort"
 )
 
 type PackWriter struct {
 	writer io.Writer
+
+	// Compat makes the writer emit the original raw-only encoding
+	// (no str8 or bin types) for peers that predate the current spec.
+	Compat bool
+
+	// Canonical makes equal values always pack to the same bytes: map
+	// keys are sorted by their packed bytes, and non-negative signed
+	// integers use the unsigned forms so the smallest encoding is used.
+	Canonical bool
+
+	// MaxDepth limits how deeply arrays, maps and structs can be nested.
+	// values with their own encoder count as a level, as do marshalers
+	// that return an array or map.  zero means no limit.  values that
+	// contain themselves are refused either way.
+	MaxDepth int
+
+	// how far down the value being packed has got, counted on the copies
+	// made on the way down: depth is the number of arrays and maps around
+	// it, and level also counts pointers
+	depth int
+	level int
+
+	// maps, slices and pointers being packed, tracked once level passes
+	// cycleCheckLevel, and the level each was entered at
+	active map[activeKey]int
+}
+
+// returned, inside an *EncodeError, for a map, slice or pointer that is
+// reached again from inside itself
+var ErrCycle = errors.New("mpack: value contains itself")
+
+// how many levels are packed before cycles are looked for.  a cycle
+// keeps going round until it is noticed, so starting late only makes the
+// path in the error longer, and shallow values pay nothing for it.
+const cycleCheckLevel = 100
+
+// a slice is identified by its length as well as where it starts, so
+// that a slice of its own first element isn't mistaken for a cycle
+type activeKey struct {
+	ptr uintptr
+	typ reflect.Type
+	len int
+}
+
+// count a level on the way into value, which packs as an array or map
+// if container is set.  tracked means value was checked for a cycle and
+// has to be let go of with leave.
+func (pw *PackWriter) enter(value interface{}, container bool) (tracked bool, err error) {
+	if container {
+		err := pw.leafDepth()
+		if err != nil {
+			return false, err
+		}
+		pw.depth++
+	}
+	pw.level++
+	if pw.level <= cycleCheckLevel {
+		return false, nil
+	}
+	key, ok := identity(reflect.ValueOf(value))
+	if !ok {
+		return false, nil
+	}
+	if pw.active == nil {
+		pw.active = make(map[activeKey]int)
+	}
+	if first, ok := pw.active[key]; ok {
+		e := &EncodeError{Err: ErrCycle, cycle: pw.level - first}
+		e.keys = make([]activeKey, pw.level+1)
+		e.steps = make([]int, pw.level+1)
+		e.keys[pw.level] = key
+		return false, e
+	}
+	pw.active[key] = pw.level
+	return true, nil
+}
+
+// called on the way out of a level that value was entered at.  a cycle
+// found further in has gone round a few times, so while the value here
+// is the one a turn further in, the path only needs to go that far.
+func trimCycle(err error, value interface{}, level int) error {
+	e, ok := err.(*EncodeError)
+	if !ok || e.cycle == 0 {
+		return err
+	}
+	// what this level added to the path
+	e.steps[level] = len(e.Path) - e.unwound
+	if key, ok := identity(reflect.ValueOf(value)); ok {
+		e.keys[level] = key
+		turn := level + e.cycle
+		switch {
+		case turn >= len(e.keys):
+			// still inside the first turn
+		case e.keys[turn] == key:
+			n := 0
+			for _, step := range e.steps[level:turn] {
+				n += step
+			}
+			e.Path = e.Path[:n]
+		default:
+			// the cycle doesn't reach out this far
+			e.cycle = 0
+		}
+	}
+	e.unwound = len(e.Path)
+	if e.cycle == 0 || level == 1 {
+		e.cycle, e.keys, e.steps = 0, nil, nil
+	}
+	return err
+}
+
+func (pw PackWriter) leave(value interface{}) {
+	key, _ := identity(reflect.ValueOf(value))
+	delete(pw.active, key)
+}
+
+// Enter and Leave bracket an array or map written with the Write methods,
+// so that encoders count it towards MaxDepth.  value is the slice or map
+// being written, and lets a value that contains itself be noticed; nil
+// will do for a struct, or while Tracking is false.  Leave isn't needed
+// if Enter fails.
+func (pw *PackWriter) Enter(value interface{}) error {
+	depth, level := pw.depth, pw.level
+	_, err := pw.enter(value, true)
+	if err != nil {
+		pw.depth, pw.level = depth, level
+	}
+	return err
+}
+
+func (pw *PackWriter) Leave(value interface{}) {
+	if pw.level > cycleCheckLevel {
+		pw.leave(value)
+	}
+	pw.depth--
+	pw.level--
+}
+
+// whether the next Enter looks for cycles, so needs its value
+func (pw PackWriter) Tracking() bool {
+	return pw.level >= cycleCheckLevel
+}
+
+// check there is room for one more array or map.  enough for the fast
+// paths, whose elements don't nest any further.
+func (pw PackWriter) leafDepth() error {
+	if pw.MaxDepth > 0 && pw.depth >= pw.MaxDepth {
+		return &EncodeError{Err: &LimitError{Limit: "depth", Max: pw.MaxDepth, Value: uint64(pw.depth + 1)}}
+	}
+	return nil
+}
+
+// only maps, slices and pointers can lead back to themselves
+func identity(v reflect.Value) (activeKey, bool) {
+	switch v.Kind() {
+	case reflect.Map, reflect.Ptr:
+		if !v.IsNil() {
+			return activeKey{v.Pointer(), v.Type(), 0}, true
+		}
+	case reflect.Slice:
+		if v.Len() > 0 {
+			return activeKey{v.Pointer(), v.Type(), v.Len()}, true
+		}
+	}
+	return activeKey{}, false
+}
+
+// whether packed data starts with an array or map header
+func startsContainer(data []byte) bool {
+	if len(data) == 0 {
+		return false
+	}
+	b := data[0]
+	switch {
+	case b >= type_fix_array_min && b <= type_fix_array_max:
+		return true
+	case b >= type_fix_map_min && b <= type_fix_map_max:
+		return true
+	}
+	switch b {
+	case type_array16, type_array32, type_map16, type_map32:
+		return true
+	}
+	return false
+}
+
+// add a step into a container to the front of an *EncodeError's path
+func addPath(err error, step string) error {
+	if e, ok := err.(*EncodeError); ok {
+		e.Path = step + e.Path
+	}
+	return err
+}
+
+func keyPath(k interface{}) string {
+	if s, ok := k.(string); ok {
+		return fmt.Sprintf("[%q]", s)
+	}
+	return fmt.Sprintf("[%v]", k)
 }
 
 func NewPackWriter(writer io.Writer) *PackWriter {
@@ -84,6 +286,9 @@ func (pw PackWriter) packInt8(n int8) (int, error) {
 }
 
 func (pw PackWriter) packInt16(n int16) (int, error) {
+	if pw.Canonical && n >= 0 {
+		return pw.packUint64(uint64(n))
+	}
 	if n >= -128 && n <= 127 {
 		return pw.packInt8(int8(n))
 	}
@@ -91,6 +296,9 @@ func (pw PackWriter) packInt16(n int16) (int, error) {
 }
 
 func (pw PackWriter) packInt32(n int32) (int, error) {
+	if pw.Canonical && n >= 0 {
+		return pw.packUint64(uint64(n))
+	}
 	if n >= -32768 && n <= 32767 {
 		return pw.packInt16(int16(n))
 	}
@@ -98,6 +306,9 @@ func (pw PackWriter) packInt32(n int32) (int, error) {
 }
 
 func (pw PackWriter) packInt64(n int64) (int, error) {
+	if pw.Canonical && n >= 0 {
+		return pw.packUint64(uint64(n))
+	}
 	if n >= -2147483648 && n <= 2147483647 {
 		return pw.packInt32(int32(n))
 	}
@@ -124,64 +335,93 @@ func (pw PackWriter) packFloat64(n float64) (int, error) {
 	return pw.writeBlock(type_double, n, 8)
 }
 
-func (pw PackWriter) packBytes(b []byte) (int, error) {
+func (pw PackWriter) packRaw(b []byte) (int, error) {
 	if len(b) < 32 {
-		pw.writeByte(type_fix_raw | uint8(len(b)))
-		pw.writer.Write(b)
-		return 1 + len(b), nil
+		numBytes, err := pw.writeByte(type_fix_raw | uint8(len(b)))
+		if err != nil {
+			return numBytes, err
+		}
+		n, err := pw.writer.Write(b)
+		return numBytes + n, err
+	} else if len(b) < 65536 {
+		return pw.packPrefixed(type_raw16, uint16(len(b)), b)
+	}
+	return pw.packPrefixed(type_raw32, uint32(len(b)), b)
+}
+
+func (pw PackWriter) packPrefixed(code byte, length interface{}, b []byte) (int, error) {
+	numBytes, err := pw.writeBlock(code, length, binary.Size(length))
+	if err != nil {
+		return numBytes, err
+	}
+	n, err := pw.writer.Write(b)
+	numBytes += n
+	return numBytes, err
+}
+
+func (pw PackWriter) packBytes(b []byte) (int, error) {
+	if pw.Compat {
+		return pw.packRaw(b)
+	}
+	if len(b) < 256 {
+		return pw.packPrefixed(type_bin8, uint8(len(b)), b)
 	} else if len(b) < 65536 {
-		pw.writeCode(type_raw16)
-		var length uint16 = uint16(len(b))
-		pw.writeBinary(length)
-		pw.writer.Write(b)
-		return 3 + len(b), nil
-	} else if uint32(len(b)) <= uint32(4294967295) {
-		pw.writeCode(type_raw32)
-		length := uint32(len(b))
-		pw.writeBinary(length)
-		pw.writer.Write(b)
-		return 5 + len(b), nil
+		return pw.packPrefixed(type_bin16, uint16(len(b)), b)
 	}
-	return 0, nil
+	return pw.packPrefixed(type_bin32, uint32(len(b)), b)
 }
 
 func (pw PackWriter) packString(s string) (int, error) {
-	return pw.packBytes([]byte(s))
+	// fixstr, str16 and str32 share their prefixes with the old raw types
+	if pw.Compat || len(s) < 32 || len(s) >= 256 {
+		return pw.packRaw([]byte(s))
+	}
+	return pw.packPrefixed(type_str8, uint8(len(s)), []byte(s))
 }
 
-func (pw PackWriter) packInt64Array(a []int64) (int, error) {
+func (pw PackWriter) packArrayHeader(length int) (int, error) {
 	numBytes := 0
-	if len(a) < 16 {
-		n, err := pw.writeCode(type_fix_array_min | uint8(len(a)))
+	if length < 16 {
+		n, err := pw.writeCode(type_fix_array_min | uint8(length))
 		if err != nil {
 			return numBytes, err
 		}
 		numBytes += n
-	} else if len(a) < 65536 {
+	} else if length < 65536 {
 		n, err := pw.writeCode(type_array16)
 		if err != nil {
 			return numBytes, err
 		}
 		numBytes += n
-		length := uint16(len(a))
-		err = pw.writeBinary(length)
+		err = pw.writeBinary(uint16(length))
 		if err != nil {
 			return numBytes, err
 		}
 		numBytes += 2
-	} else if uint32(len(a)) <= uint32(4294967295) {
+	} else if uint32(length) <= uint32(4294967295) {
 		n, err := pw.writeCode(type_array32)
 		if err != nil {
 			return numBytes, err
 		}
 		numBytes += n
-		length := uint32(len(a))
-		err = pw.writeBinary(length)
+		err = pw.writeBinary(uint32(length))
 		if err != nil {
 			return numBytes, err
 		}
 		numBytes += 4
 	}
+	return numBytes, nil
+}
+
+func (pw PackWriter) packInt64Array(a []int64) (int, error) {
+	err := pw.leafDepth()
+	if err != nil {
+		return 0, err
+	}
+	numBytes, err := pw.packArrayHeader(len(a))
+	if err != nil {
+		return numBytes, err
+	}
 
 	for i := 0; i < len(a); i++ {
 		n, err := pw.packInt64(a[i])
@@ -193,102 +433,457 @@ func (pw PackWriter) packInt64Array(a []int64) (int, error) {
 	return numBytes, nil
 }
 
-func (pw PackWriter) packArray(a reflect.Value) (int, error) {
-	numBytes := 0
-	if a.Len() < 16 {
-		n, err := pw.writeCode(type_fix_array_min | uint8(a.Len()))
+func (pw PackWriter) packIntArray(a []int) (int, error) {
+	err := pw.leafDepth()
+	if err != nil {
+		return 0, err
+	}
+	numBytes, err := pw.packArrayHeader(len(a))
+	if err != nil {
+		return numBytes, err
+	}
+	for _, v := range a {
+		n, err := pw.packInt64(int64(v))
+		numBytes += n
 		if err != nil {
 			return numBytes, err
 		}
+	}
+	return numBytes, nil
+}
+
+func (pw PackWriter) packInt32Array(a []int32) (int, error) {
+	err := pw.leafDepth()
+	if err != nil {
+		return 0, err
+	}
+	numBytes, err := pw.packArrayHeader(len(a))
+	if err != nil {
+		return numBytes, err
+	}
+	for _, v := range a {
+		n, err := pw.packInt32(v)
 		numBytes += n
-	} else if a.Len() < 65536 {
-		n, err := pw.writeCode(type_array16)
 		if err != nil {
 			return numBytes, err
 		}
+	}
+	return numBytes, nil
+}
+
+func (pw PackWriter) packUint64Array(a []uint64) (int, error) {
+	err := pw.leafDepth()
+	if err != nil {
+		return 0, err
+	}
+	numBytes, err := pw.packArrayHeader(len(a))
+	if err != nil {
+		return numBytes, err
+	}
+	for _, v := range a {
+		n, err := pw.packUint64(v)
 		numBytes += n
-		length := uint16(a.Len())
-		err = pw.writeBinary(length)
 		if err != nil {
 			return numBytes, err
 		}
-		numBytes += 2
-	} else if uint32(a.Len()) <= uint32(4294967295) {
-		n, err := pw.writeCode(type_array32)
+	}
+	return numBytes, nil
+}
+
+func (pw PackWriter) packFloat32Array(a []float32) (int, error) {
+	err := pw.leafDepth()
+	if err != nil {
+		return 0, err
+	}
+	numBytes, err := pw.packArrayHeader(len(a))
+	if err != nil {
+		return numBytes, err
+	}
+	for _, v := range a {
+		n, err := pw.packFloat32(v)
+		numBytes += n
 		if err != nil {
 			return numBytes, err
 		}
+	}
+	return numBytes, nil
+}
+
+func (pw PackWriter) packFloat64Array(a []float64) (int, error) {
+	err := pw.leafDepth()
+	if err != nil {
+		return 0, err
+	}
+	numBytes, err := pw.packArrayHeader(len(a))
+	if err != nil {
+		return numBytes, err
+	}
+	for _, v := range a {
+		n, err := pw.packFloat64(v)
 		numBytes += n
-		length := uint32(a.Len())
-		err = pw.writeBinary(length)
 		if err != nil {
 			return numBytes, err
 		}
-		numBytes += 4
+	}
+	return numBytes, nil
+}
+
+func (pw PackWriter) packStringArray(a []string) (int, error) {
+	err := pw.leafDepth()
+	if err != nil {
+		return 0, err
+	}
+	numBytes, err := pw.packArrayHeader(len(a))
+	if err != nil {
+		return numBytes, err
+	}
+	for _, v := range a {
+		n, err := pw.packString(v)
+		numBytes += n
+		if err != nil {
+			return numBytes, err
+		}
+	}
+	return numBytes, nil
+}
+
+func (pw PackWriter) packBoolArray(a []bool) (int, error) {
+	err := pw.leafDepth()
+	if err != nil {
+		return 0, err
+	}
+	numBytes, err := pw.packArrayHeader(len(a))
+	if err != nil {
+		return numBytes, err
+	}
+	for _, v := range a {
+		n, err := pw.packBool(v)
+		numBytes += n
+		if err != nil {
+			return numBytes, err
+		}
+	}
+	return numBytes, nil
+}
+
+func (pw PackWriter) packArray(a reflect.Value) (int, error) {
+	numBytes, err := pw.packArrayHeader(a.Len())
+	if err != nil {
+		return numBytes, err
 	}
 	for i := 0; i < a.Len(); i++ {
 		elt := a.Index(i)
 		n, err := pw.pack(elt.Interface())
 		if err != nil {
-			return numBytes, err
+			return numBytes, addPath(err, fmt.Sprintf("[%d]", i))
 		}
 		numBytes += n
 	}
 	return numBytes, nil
 }
 
-func (pw PackWriter) packMap(m reflect.Value) (int, error) {
+func (pw PackWriter) packMapHeader(length int) (int, error) {
 	numBytes := 0
-
-	if m.Len() < 16 {
-		n, err := pw.writeCode(type_fix_map_min | uint8(m.Len()))
+	if length < 16 {
+		n, err := pw.writeCode(type_fix_map_min | uint8(length))
 		if err != nil {
 			return numBytes, err
 		}
 		numBytes += n
-	} else if m.Len() < 65536 {
+	} else if length < 65536 {
 		n, err := pw.writeCode(type_map16)
 		if err != nil {
 			return numBytes, err
 		}
 		numBytes += n
-		length := uint16(m.Len())
-		err = pw.writeBinary(length)
+		err = pw.writeBinary(uint16(length))
 		if err != nil {
 			return numBytes, err
 		}
 		numBytes += 2
-	} else if uint32(m.Len()) <= uint32(4294967295) {
+	} else if uint32(length) <= uint32(4294967295) {
 		n, err := pw.writeCode(type_map32)
 		if err != nil {
 			return numBytes, err
 		}
 		numBytes += n
-		length := uint32(m.Len())
-		err = pw.writeBinary(length)
+		err = pw.writeBinary(uint32(length))
 		if err != nil {
 			return numBytes, err
 		}
 		numBytes += 4
 	}
+	return numBytes, nil
+}
+
+type packedKey struct {
+	packed []byte
+	key    reflect.Value
+}
+
+type byPacked []packedKey
+
+func (x byPacked) Len() int           { return len(x) }
+func (x byPacked) Swap(i, j int)      { x[i], x[j] = x[j], x[i] }
+func (x byPacked) Less(i, j int) bool { return bytes.Compare(x[i].packed, x[j].packed) < 0 }
+
+// write the entries of m ordered by the packed bytes of their keys
+func (pw PackWriter) packSortedMap(m reflect.Value, numBytes int) (int, error) {
+	keys := make([]packedKey, 0, m.Len())
+	kw := pw
+	for _, k := range m.MapKeys() {
+		w := &appendWriter{}
+		kw.writer = w
+		_, err := kw.pack(k.Interface())
+		if err != nil {
+			return numBytes, addPath(err, keyPath(k.Interface()))
+		}
+		keys = append(keys, packedKey{w.buf, k})
+	}
+	sort.Sort(byPacked(keys))
+
+	for _, k := range keys {
+		n, err := pw.writer.Write(k.packed)
+		numBytes += n
+		if err != nil {
+			return numBytes, err
+		}
+		n, err = pw.pack(m.MapIndex(k.key).Interface())
+		numBytes += n
+		if err != nil {
+			return numBytes, addPath(err, keyPath(k.key.Interface()))
+		}
+	}
+	return numBytes, nil
+}
+
+func (pw PackWriter) packMap(m reflect.Value) (int, error) {
+	numBytes, err := pw.packMapHeader(m.Len())
+	if err != nil {
+		return numBytes, err
+	}
+
+	if pw.Canonical {
+		return pw.packSortedMap(m, numBytes)
+	}
 
 	keys := m.MapKeys()
 	for i := 0; i < len(keys); i++ {
 		n, err := pw.pack(keys[i].Interface())
 		if err != nil {
-			return numBytes, err
+			return numBytes, addPath(err, keyPath(keys[i].Interface()))
 		}
 		numBytes += n
 		n, err = pw.pack(m.MapIndex(keys[i]).Interface())
+		if err != nil {
+			return numBytes, addPath(err, keyPath(keys[i].Interface()))
+		}
+		numBytes += n
+	}
+
+	return numBytes, nil
+}
+
+// canonical maps need their keys sorted, which packMap does
+func (pw PackWriter) packStringMap(m map[string]string) (int, error) {
+	err := pw.leafDepth()
+	if err != nil {
+		return 0, err
+	}
+	if pw.Canonical {
+		return pw.packMap(reflect.ValueOf(m))
+	}
+	numBytes, err := pw.packMapHeader(len(m))
+	if err != nil {
+		return numBytes, err
+	}
+	for k, v := range m {
+		n, err := pw.packString(k)
+		numBytes += n
 		if err != nil {
 			return numBytes, err
 		}
+		n, err = pw.packString(v)
 		numBytes += n
+		if err != nil {
+			return numBytes, err
+		}
 	}
+	return numBytes, nil
+}
 
+func (pw PackWriter) packStringInterfaceMap(m map[string]interface{}) (int, error) {
+	if pw.Canonical {
+		return pw.packMap(reflect.ValueOf(m))
+	}
+	numBytes, err := pw.packMapHeader(len(m))
+	if err != nil {
+		return numBytes, err
+	}
+	for k, v := range m {
+		n, err := pw.packString(k)
+		numBytes += n
+		if err != nil {
+			return numBytes, err
+		}
+		n, err = pw.pack(v)
+		numBytes += n
+		if err != nil {
+			return numBytes, addPath(err, keyPath(k))
+		}
+	}
+	return numBytes, nil
+}
+
+func (pw PackWriter) packStruct(s reflect.Value) (int, error) {
+	fields := cachedFields(s.Type())
+	values := make([]reflect.Value, len(fields))
+	count := 0
+	for i, f := range fields {
+		v, ok := fieldByIndex(s, f.index)
+		if !ok || (f.omitEmpty && isEmptyValue(v)) {
+			continue
+		}
+		values[i] = v
+		count++
+	}
+
+	numBytes, err := pw.packMapHeader(count)
+	if err != nil {
+		return numBytes, err
+	}
+	for i, f := range fields {
+		if !values[i].IsValid() {
+			continue
+		}
+		n, err := pw.packString(f.name)
+		if err != nil {
+			return numBytes, err
+		}
+		numBytes += n
+		n, err = pw.pack(values[i].Interface())
+		if err != nil {
+			return numBytes, addPath(err, "."+f.name)
+		}
+		numBytes += n
+	}
 	return numBytes, nil
 }
 
+func (pw PackWriter) packExt(code int8, data []byte) (int, error) {
+	var numBytes int
+	var err error
+	switch len(data) {
+	case 1:
+		numBytes, err = pw.writeCode(type_fixext1)
+	case 2:
+		numBytes, err = pw.writeCode(type_fixext2)
+	case 4:
+		numBytes, err = pw.writeCode(type_fixext4)
+	case 8:
+		numBytes, err = pw.writeCode(type_fixext8)
+	case 16:
+		numBytes, err = pw.writeCode(type_fixext16)
+	default:
+		if len(data) < 256 {
+			numBytes, err = pw.writeBlock(type_ext8, uint8(len(data)), 1)
+		} else if len(data) < 65536 {
+			numBytes, err = pw.writeBlock(type_ext16, uint16(len(data)), 2)
+		} else {
+			numBytes, err = pw.writeBlock(type_ext32, uint32(len(data)), 4)
+		}
+	}
+	if err != nil {
+		return numBytes, err
+	}
+	n, err := pw.writeByte(byte(code))
+	numBytes += n
+	if err != nil {
+		return numBytes, err
+	}
+	n, err = pw.writer.Write(data)
+	numBytes += n
+	return numBytes, err
+}
+
+func (pw PackWriter) packRegisteredExt(ext *extType, value interface{}) (int, error) {
+	data, err := ext.encode(value)
+	if err != nil {
+		return 0, err
+	}
+	return pw.packExt(ext.code, data)
+}
+
+var errNegativeLength = errors.New("mpack: negative length")
+
+// the Write methods let values be packed as they are produced.  An array
+// or map header must be followed by that many elements or key/value pairs.
+
+func (pw PackWriter) WriteArrayHeader(n int) (int, error) {
+	if n < 0 {
+		return 0, errNegativeLength
+	}
+	return pw.packArrayHeader(n)
+}
+
+func (pw PackWriter) WriteMapHeader(n int) (int, error) {
+	if n < 0 {
+		return 0, errNegativeLength
+	}
+	return pw.packMapHeader(n)
+}
+
+func (pw PackWriter) WriteString(s string) (int, error) {
+	return pw.packString(s)
+}
+
+func (pw PackWriter) WriteBytes(b []byte) (int, error) {
+	return pw.packBytes(b)
+}
+
+func (pw PackWriter) WriteInt(n int64) (int, error) {
+	return pw.packInt64(n)
+}
+
+func (pw PackWriter) WriteUint(n uint64) (int, error) {
+	return pw.packUint64(n)
+}
+
+func (pw PackWriter) WriteFloat(f float64) (int, error) {
+	return pw.packFloat64(f)
+}
+
+func (pw PackWriter) WriteFloat32(f float32) (int, error) {
+	return pw.packFloat32(f)
+}
+
+func (pw PackWriter) WriteBool(b bool) (int, error) {
+	return pw.packBool(b)
+}
+
+func (pw PackWriter) WriteNil() (int, error) {
+	return pw.packNil()
+}
+
+func (pw PackWriter) packMarshaler(m MsgpackMarshaler) (int, error) {
+	data, err := m.MarshalMsgpack()
+	if err != nil {
+		return 0, err
+	}
+	if startsContainer(data) {
+		err := pw.leafDepth()
+		if err != nil {
+			return 0, err
+		}
+	}
+	return pw.writer.Write(data)
+}
+
+// Encode packs value to the underlying writer
+func (pw PackWriter) Encode(value interface{}) (int, error) {
+	return pw.pack(value)
+}
+
 func (pw PackWriter) pack(value interface{}) (int, error) {
 	if value == nil {
 		return pw.packNil()
@@ -324,12 +919,86 @@ func (pw PackWriter) pack(value interface{}) (int, error) {
 		return pw.packBytes(tvalue)
 	case []int64:
 		return pw.packInt64Array(tvalue)
+	case []int:
+		return pw.packIntArray(tvalue)
+	case []int32:
+		return pw.packInt32Array(tvalue)
+	case []uint64:
+		return pw.packUint64Array(tvalue)
+	case []float32:
+		return pw.packFloat32Array(tvalue)
+	case []float64:
+		return pw.packFloat64Array(tvalue)
+	case []string:
+		return pw.packStringArray(tvalue)
+	case []bool:
+		return pw.packBoolArray(tvalue)
+	case map[string]string:
+		return pw.packStringMap(tvalue)
+	case map[string]interface{}:
+		tracked, err := pw.enter(tvalue, true)
+		if err != nil {
+			return 0, err
+		}
+		if tracked {
+			defer pw.leave(tvalue)
+		}
+		n, err := pw.packStringInterfaceMap(tvalue)
+		return n, trimCycle(err, tvalue, pw.level)
 	case string:
 		return pw.packString(tvalue)
+	case Ext:
+		return pw.packExt(tvalue.Type, tvalue.Data)
+	}
+
+	// the built-in types above have no methods of their own
+	if e, ok := encoder(value); ok {
+		tracked, err := pw.enter(value, true)
+		if err != nil {
+			return 0, err
+		}
+		if tracked {
+			defer pw.leave(value)
+		}
+		// a copy, so that only this path puts a PackWriter on the heap
+		epw := pw
+		n, err := e.EncodeMsgpack(&epw)
+		return n, trimCycle(err, value, pw.level)
+	}
+	if m, ok := marshaler(value); ok {
+		return pw.packMarshaler(m)
+	}
+
+	if ext, present := extForType(reflect.TypeOf(value)); present {
+		return pw.packRegisteredExt(ext, value)
 	}
 
-	// see if it is an array...
 	rvalue := reflect.ValueOf(value)
+	switch rvalue.Kind() {
+	case reflect.Array, reflect.Slice, reflect.Map, reflect.Struct, reflect.Ptr:
+		tracked, err := pw.enter(value, rvalue.Kind() != reflect.Ptr)
+		if err != nil {
+			return 0, err
+		}
+		if tracked {
+			defer pw.leave(value)
+		}
+		n, err := pw.packContainer(rvalue)
+		return n, trimCycle(err, value, pw.level)
+	}
+
+	// named basic types, like type Status int, pack as the type they
+	// are defined as
+	if t, ok := basicTypes[rvalue.Kind()]; ok {
+		return pw.pack(rvalue.Convert(t).Interface())
+	}
+
+	return 0, &UnsupportedTypeError{rvalue.Type()}
+}
+
+// an array, slice, map, struct or pointer, once its level is entered
+func (pw PackWriter) packContainer(rvalue reflect.Value) (int, error) {
+	// see if it is an array...
 	if rvalue.Kind() == reflect.Array || rvalue.Kind() == reflect.Slice {
 		return pw.packArray(rvalue)
 	}
@@ -339,6 +1008,30 @@ func (pw PackWriter) pack(value interface{}) (int, error) {
 		return pw.packMap(rvalue)
 	}
 
-	fmt.Printf("unknown type: %s\n", value)
-	return 0, nil
+	// a struct is packed as a map of its exported fields
+	if rvalue.Kind() == reflect.Struct {
+		return pw.packStruct(rvalue)
+	}
+
+	if rvalue.IsNil() {
+		return pw.packNil()
+	}
+	return pw.pack(rvalue.Elem().Interface())
+}
+
+var basicTypes = map[reflect.Kind]reflect.Type{
+	reflect.Bool:    reflect.TypeOf(false),
+	reflect.Int:     reflect.TypeOf(int(0)),
+	reflect.Int8:    reflect.TypeOf(int8(0)),
+	reflect.Int16:   reflect.TypeOf(int16(0)),
+	reflect.Int32:   reflect.TypeOf(int32(0)),
+	reflect.Int64:   reflect.TypeOf(int64(0)),
+	reflect.Uint:    reflect.TypeOf(uint(0)),
+	reflect.Uint8:   reflect.TypeOf(uint8(0)),
+	reflect.Uint16:  reflect.TypeOf(uint16(0)),
+	reflect.Uint32:  reflect.TypeOf(uint32(0)),
+	reflect.Uint64:  reflect.TypeOf(uint64(0)),
+	reflect.Float32: reflect.TypeOf(float32(0)),
+	reflect.Float64: reflect.TypeOf(float64(0)),
+	reflect.String:  reflect.TypeOf(""),
 }
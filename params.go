@@ -1,5 +1,7 @@
 package mpack
 
+import "time"
+
 type Params struct {
 	raw *Map
 }
@@ -41,6 +43,10 @@ func (p Params) StringIndex(key interface{}) (string, bool) {
 	return p.raw.StringIndex(key)
 }
 
+func (p Params) TimeIndex(key interface{}) (time.Time, bool) {
+	return p.raw.TimeIndex(key)
+}
+
 func (p Params) ArrayIndex(key interface{}) (*Array, bool) {
 	return p.raw.ArrayIndex(key)
 }
@@ -29,6 +29,11 @@ func Handle(name string, function handler) error {
 }
 
 func ListenAndServe(host string) {
+	ListenAndServeOptions(host, DefaultDecoderOptions)
+}
+
+// like ListenAndServe, with the limits used to decode requests
+func ListenAndServeOptions(host string, options DecoderOptions) {
 	tcpAddr, err := net.ResolveTCPAddr("tcp", host)
 	if err != nil {
 		log.Printf("error resolving address %s: %s", host, err)
@@ -51,17 +56,18 @@ func ListenAndServe(host string) {
 			log.Fatalf("accept error: %v", err)
 		}
 
-		go serve(conn)
+		go serve(conn, options)
 		log.Printf("connection established: %s", conn)
 	}
 }
 
-func serve(conn net.Conn) {
+func serve(conn net.Conn, options DecoderOptions) {
 	results := make(chan []byte, 1024)
 	quit := make(chan bool)
 	go sendResults(results, quit, conn)
+	pr := NewPackReaderOptions(conn, options)
 	for {
-		rpc, _, err := Unpack(conn)
+		rpc, _, err := pr.unpack()
 		if err != nil {
 			quit <- true
 			return
@@ -102,7 +108,7 @@ func processRPC(rpc interface{}, results chan []byte) {
 	}()
 	startTime := time.Now()
 	args := NewArray(rpc)
-	if args.Item(0) != rpc_request {
+	if !isMessageType(args, rpc_request) {
 		log.Printf("did not receive an rpc request")
 		return
 	}
@@ -118,7 +124,7 @@ func processRPC(rpc interface{}, results chan []byte) {
 		log.Printf("error:  no procedure '%s'", procedure)
 		response, err := errorResponse(msgid, "no procedure: "+procedure)
 		if err != nil {
-			log.Printf("error making err response:", err)
+			log.Printf("error making err response: %s", err)
 			return
 		}
 		results <- response
@@ -130,7 +136,7 @@ func processRPC(rpc interface{}, results chan []byte) {
 		log.Printf("error calling procedure '%s': %s", procedure, err)
 		response, err := errorResponse(msgid, err.Error())
 		if err != nil {
-			log.Printf("error making err response:", err)
+			log.Printf("error making err response: %s", err)
 			return
 		}
 		results <- response
@@ -139,7 +145,7 @@ func processRPC(rpc interface{}, results chan []byte) {
 
 	response, err := successResponse(msgid, result)
 	if err != nil {
-		log.Printf("error making success response:", err)
+		log.Printf("error making success response: %s", err)
 		return
 	}
 	results <- response
@@ -147,6 +153,13 @@ func processRPC(rpc interface{}, results chan []byte) {
 	log.Printf("rpc execute time: %.3f ms", (float64)(time.Now().Sub(startTime))/1e6)
 }
 
+// UintItem reads anything that isn't a number as 0, which is the request
+// type, so the type has to be checked as an integer first
+func isMessageType(msg *Array, code byte) bool {
+	n, ok := tokenUint64(msg.Item(0))
+	return ok && n == uint64(code)
+}
+
 func errorResponse(msgid uint32, message string) ([]byte, error) {
 	response := makeResponse(msgid)
 	response[2] = message
@@ -183,11 +196,18 @@ type RPCClient struct {
 	idCounter      int64
 	outputChannels map[int64]chan interface{}
 	Connected      bool
+	options        DecoderOptions
 }
 
 func NewRPCClient(host string) (*RPCClient, error) {
+	return NewRPCClientOptions(host, DefaultDecoderOptions)
+}
+
+// like NewRPCClient, with the limits used to decode responses
+func NewRPCClientOptions(host string, options DecoderOptions) (*RPCClient, error) {
 	result := new(RPCClient)
 	result.Host = host
+	result.options = options
 
 	result.outputChannels = make(map[int64]chan interface{})
 
@@ -205,8 +225,9 @@ func NewRPCClient(host string) (*RPCClient, error) {
 }
 
 func (client *RPCClient) StartReader() {
+	pr := NewPackReaderOptions(client.conn, client.options)
 	for {
-		generic, _, err := Unpack(client.conn)
+		generic, _, err := pr.unpack()
 		if err != nil {
 			if err == io.EOF {
 				log.Printf("%s: eof", client.Host)
@@ -219,7 +240,7 @@ func (client *RPCClient) StartReader() {
 			continue
 		}
 		response := NewArray(generic)
-		if response.Item(0) != rpc_response {
+		if !isMessageType(response, rpc_response) {
 			log.Printf("didn't get rpc_response")
 			continue
 		}
@@ -311,9 +332,9 @@ func (cp *ClientPool) Get() (*RPCClient, error) {
 	defer cp.lock.Unlock()
 
 	for len(cp.clients) > 0 {
-                n := len(cp.clients)
+		n := len(cp.clients)
 		result := cp.clients[n-1]
-                cp.clients = cp.clients[:n-1]
+		cp.clients = cp.clients[:n-1]
 		if result.Connected {
 			return result, nil
 		}
@@ -25,12 +25,14 @@ type service struct {
 
 type Server struct {
 	Host       string
+	Options    DecoderOptions
 	serviceMap map[string]*service
 }
 
 func NewServer(host string) *Server {
 	result := new(Server)
 	result.Host = host
+	result.Options = DefaultDecoderOptions
 	return result
 }
 
@@ -65,7 +67,7 @@ func (server *Server) Register(rcvr interface{}) error {
 	}
 
 	server.serviceMap[s.name] = s
-	log.Printf("service map: %s", server.serviceMap)
+	log.Printf("service map: %v", server.serviceMap)
 	return nil
 }
 
@@ -132,9 +134,10 @@ func (server *Server) replyError(conn net.Conn, msgid uint32, errmsg string) err
 }
 
 func (server *Server) handleRPC(conn net.Conn) {
+	pr := NewPackReaderOptions(conn, server.Options)
 	for {
 		startTime := time.Now()
-		rpc, _, err := Unpack(conn)
+		rpc, _, err := pr.unpack()
 		if err != nil {
 			log.Printf("read error: %s", err)
 			return
@@ -142,10 +145,9 @@ func (server *Server) handleRPC(conn net.Conn) {
 
 		// log.Printf("rpc (%d bytes): %s", bytesRead, rpc)
 		args := rpc.([]interface{})
-		if args[0] == rpc_request {
-			mv := reflect.ValueOf(args[1])
-			msgid := uint32(mv.Uint())
-			procedure := string(args[2].([]uint8))
+		if isMessageType(NewArray(args), rpc_request) {
+			msgid := NewArray(args).Uint32Item(1)
+			procedure := NewArray(args).StringItem(2)
 			procedureArgs := args[3]
 			//			log.Printf("rpc request: msgid=%d, proc=%s, args=%s", msgid, procedure, procedureArgs)
 			procElts := strings.Split(procedure, "/")
@@ -211,13 +213,16 @@ func (server *Server) Run() {
 type Client struct {
 	Host       string
 	Connection *net.TCPConn
+	Options    DecoderOptions
 	Connected  bool
 	msgid      int64
+	reader     *PackReader
 }
 
 func NewClient(host string) *Client {
 	result := new(Client)
 	result.Host = host
+	result.Options = DefaultDecoderOptions
 	result.Connected = false
 	result.msgid = 0
 	return result
@@ -232,6 +237,7 @@ func (c *Client) Connect() error {
 	if err != nil {
 		return err
 	}
+	c.reader = NewPackReaderOptions(c.Connection, c.Options)
 	c.Connected = true
 	return nil
 }
@@ -266,7 +272,7 @@ func (c *Client) Call(method string, args interface{}) (interface{}, error) {
 		return nil, err
 	}
 
-	rpc, _, err := Unpack(c.Connection)
+	rpc, _, err := c.reader.unpack()
 	if err != nil {
 		log.Printf("read error: %s", err)
 		return nil, err
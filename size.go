@@ -0,0 +1,252 @@
+package mpack
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// EncodedSize returns the number of bytes Pack would write for value,
+// without packing it.
+func EncodedSize(value interface{}) (int, error) {
+	return PackWriter{}.EncodedSize(value)
+}
+
+// EncodedSize returns the number of bytes pw would write for value, which
+// depends on its Compat and Canonical settings.  The choices follow the
+// pack functions exactly.
+func (pw PackWriter) EncodedSize(value interface{}) (int, error) {
+	if value == nil {
+		return 1, nil
+	}
+	switch tvalue := value.(type) {
+	case int8:
+		return pw.intSize(int64(tvalue)), nil
+	case int16:
+		return pw.intSize(int64(tvalue)), nil
+	case int32:
+		return pw.intSize(int64(tvalue)), nil
+	case int64:
+		return pw.intSize(tvalue), nil
+	case int:
+		return pw.intSize(int64(tvalue)), nil
+	case uint8:
+		return uintSize(uint64(tvalue)), nil
+	case uint16:
+		return uintSize(uint64(tvalue)), nil
+	case uint32:
+		return uintSize(uint64(tvalue)), nil
+	case uint64:
+		return uintSize(tvalue), nil
+	case uint:
+		return uintSize(uint64(tvalue)), nil
+	case bool:
+		return 1, nil
+	case float32:
+		return 5, nil
+	case float64:
+		return 9, nil
+	case []byte:
+		return pw.bytesSize(len(tvalue)), nil
+	case []int64:
+		err := pw.leafDepth()
+		if err != nil {
+			return 0, err
+		}
+		size := headerSize(len(tvalue))
+		for _, n := range tvalue {
+			size += pw.intSize(n)
+		}
+		return size, nil
+	case string:
+		return pw.stringSize(len(tvalue)), nil
+	case Ext:
+		return extSize(len(tvalue.Data)), nil
+	}
+
+	// the built-in types above have no methods of their own
+	if e, ok := encoder(value); ok {
+		tracked, err := pw.enter(value, true)
+		if err != nil {
+			return 0, err
+		}
+		if tracked {
+			defer pw.leave(value)
+		}
+		// nothing to go on but the encoder itself
+		epw := pw
+		epw.writer = io.Discard
+		n, err := e.EncodeMsgpack(&epw)
+		return n, trimCycle(err, value, pw.level)
+	}
+	if m, ok := marshaler(value); ok {
+		data, err := m.MarshalMsgpack()
+		if err == nil && startsContainer(data) {
+			err = pw.leafDepth()
+		}
+		return len(data), err
+	}
+
+	if ext, present := extForType(reflect.TypeOf(value)); present {
+		data, err := ext.encode(value)
+		return extSize(len(data)), err
+	}
+
+	rvalue := reflect.ValueOf(value)
+	switch rvalue.Kind() {
+	case reflect.Array, reflect.Slice, reflect.Map, reflect.Struct, reflect.Ptr:
+		tracked, err := pw.enter(value, rvalue.Kind() != reflect.Ptr)
+		if err != nil {
+			return 0, err
+		}
+		if tracked {
+			defer pw.leave(value)
+		}
+		n, err := pw.containerSize(rvalue)
+		return n, trimCycle(err, value, pw.level)
+	}
+	if t, ok := basicTypes[rvalue.Kind()]; ok {
+		return pw.EncodedSize(rvalue.Convert(t).Interface())
+	}
+	return 0, &UnsupportedTypeError{rvalue.Type()}
+}
+
+// see packContainer
+func (pw PackWriter) containerSize(rvalue reflect.Value) (int, error) {
+	switch rvalue.Kind() {
+	case reflect.Array, reflect.Slice:
+		size := headerSize(rvalue.Len())
+		for i := 0; i < rvalue.Len(); i++ {
+			n, err := pw.EncodedSize(rvalue.Index(i).Interface())
+			size += n
+			if err != nil {
+				return size, addPath(err, fmt.Sprintf("[%d]", i))
+			}
+		}
+		return size, nil
+	case reflect.Map:
+		size := headerSize(rvalue.Len())
+		for _, k := range rvalue.MapKeys() {
+			n, err := pw.EncodedSize(k.Interface())
+			size += n
+			if err != nil {
+				return size, addPath(err, keyPath(k.Interface()))
+			}
+			n, err = pw.EncodedSize(rvalue.MapIndex(k).Interface())
+			size += n
+			if err != nil {
+				return size, addPath(err, keyPath(k.Interface()))
+			}
+		}
+		return size, nil
+	case reflect.Struct:
+		return pw.structSize(rvalue)
+	}
+	if rvalue.IsNil() {
+		return 1, nil
+	}
+	return pw.EncodedSize(rvalue.Elem().Interface())
+}
+
+func (pw PackWriter) structSize(s reflect.Value) (int, error) {
+	size := 0
+	count := 0
+	for _, f := range cachedFields(s.Type()) {
+		v, ok := fieldByIndex(s, f.index)
+		if !ok || (f.omitEmpty && isEmptyValue(v)) {
+			continue
+		}
+		count++
+		n, err := pw.EncodedSize(v.Interface())
+		size += pw.stringSize(len(f.name)) + n
+		if err != nil {
+			return size, addPath(err, "."+f.name)
+		}
+	}
+	return headerSize(count) + size, nil
+}
+
+// see packInt8 through packInt64
+func (pw PackWriter) intSize(n int64) int {
+	switch {
+	case pw.Canonical && n >= 0:
+		return uintSize(uint64(n))
+	case n >= -32 && n <= 127:
+		return 1
+	case n >= -128 && n <= 127:
+		return 2
+	case n >= -32768 && n <= 32767:
+		return 3
+	case n >= -2147483648 && n <= 2147483647:
+		return 5
+	}
+	return 9
+}
+
+func uintSize(n uint64) int {
+	switch {
+	case n < 128:
+		return 1
+	case n < 0x100:
+		return 2
+	case n < 65536:
+		return 3
+	case n < 4294967296:
+		return 5
+	}
+	return 9
+}
+
+func rawSize(length int) int {
+	switch {
+	case length < 32:
+		return 1 + length
+	case length < 65536:
+		return 3 + length
+	}
+	return 5 + length
+}
+
+func (pw PackWriter) bytesSize(length int) int {
+	switch {
+	case pw.Compat:
+		return rawSize(length)
+	case length < 256:
+		return 2 + length
+	case length < 65536:
+		return 3 + length
+	}
+	return 5 + length
+}
+
+func (pw PackWriter) stringSize(length int) int {
+	if pw.Compat || length < 32 || length >= 256 {
+		return rawSize(length)
+	}
+	return 2 + length
+}
+
+// array and map headers are the same size
+func headerSize(length int) int {
+	switch {
+	case length < 16:
+		return 1
+	case length < 65536:
+		return 3
+	}
+	return 5
+}
+
+func extSize(length int) int {
+	switch length {
+	case 1, 2, 4, 8, 16:
+		return 2 + length
+	}
+	switch {
+	case length < 256:
+		return 3 + length
+	case length < 65536:
+		return 4 + length
+	}
+	return 6 + length
+}
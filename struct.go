@@ -0,0 +1,201 @@
+package mpack
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// a single exported struct field as it appears on the wire
+type field struct {
+	name      string
+	index     []int
+	tagged    bool
+	omitEmpty bool
+}
+
+var fieldCache struct {
+	sync.RWMutex
+	m map[reflect.Type][]field
+}
+
+// parse a `msgpack:"name,omitempty"` tag
+func parseTag(tag string) (string, bool) {
+	parts := strings.Split(tag, ",")
+	omitEmpty := false
+	for _, opt := range parts[1:] {
+		if opt == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	return parts[0], omitEmpty
+}
+
+// cachedFields returns the encodable fields of struct type t, in
+// declaration order.
+func cachedFields(t reflect.Type) []field {
+	fieldCache.RLock()
+	fields, present := fieldCache.m[t]
+	fieldCache.RUnlock()
+	if present {
+		return fields
+	}
+
+	fields = structFields(t)
+
+	fieldCache.Lock()
+	if fieldCache.m == nil {
+		fieldCache.m = make(map[reflect.Type][]field)
+	}
+	fieldCache.m[t] = fields
+	fieldCache.Unlock()
+	return fields
+}
+
+// walk t breadth first so that shallower fields hide deeper ones,
+// the same way Go resolves promoted fields.
+func structFields(t reflect.Type) []field {
+	type level struct {
+		typ   reflect.Type
+		index []int
+	}
+
+	var fields []field
+	count := make(map[string]int)
+	visited := make(map[reflect.Type]bool)
+	next := []level{{typ: t}}
+
+	for len(next) > 0 {
+		current := next
+		next = nil
+		depthFields := make([]field, 0)
+
+		for _, l := range current {
+			if visited[l.typ] {
+				continue
+			}
+			visited[l.typ] = true
+
+			for i := 0; i < l.typ.NumField(); i++ {
+				sf := l.typ.Field(i)
+				tag := sf.Tag.Get("msgpack")
+				if tag == "-" {
+					continue
+				}
+				name, omitEmpty := parseTag(tag)
+
+				index := make([]int, len(l.index)+1)
+				copy(index, l.index)
+				index[len(l.index)] = i
+
+				ft := sf.Type
+				if ft.Kind() == reflect.Ptr {
+					ft = ft.Elem()
+				}
+				if sf.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+					next = append(next, level{typ: ft, index: index})
+					continue
+				}
+				if sf.PkgPath != "" {
+					// unexported
+					continue
+				}
+
+				tagged := name != ""
+				if !tagged {
+					name = sf.Name
+				}
+				depthFields = append(depthFields, field{name: name, index: index, tagged: tagged, omitEmpty: omitEmpty})
+			}
+		}
+
+		// a name seen at a shallower depth wins.  at the same depth a
+		// tagged field wins, otherwise the name is ambiguous and dropped.
+		byName := make(map[string][]field)
+		for _, f := range depthFields {
+			if count[f.name] > 0 {
+				continue
+			}
+			byName[f.name] = append(byName[f.name], f)
+		}
+		for _, f := range depthFields {
+			candidates, present := byName[f.name]
+			if !present {
+				continue
+			}
+			delete(byName, f.name)
+			count[f.name]++
+			if winner, ok := dominantField(candidates); ok {
+				fields = append(fields, winner)
+			}
+		}
+	}
+
+	sort.Sort(byIndex(fields))
+	return fields
+}
+
+func dominantField(fields []field) (field, bool) {
+	if len(fields) == 1 {
+		return fields[0], true
+	}
+	var winner field
+	tagged := 0
+	for _, f := range fields {
+		if f.tagged {
+			winner = f
+			tagged++
+		}
+	}
+	return winner, tagged == 1
+}
+
+type byIndex []field
+
+func (x byIndex) Len() int      { return len(x) }
+func (x byIndex) Swap(i, j int) { x[i], x[j] = x[j], x[i] }
+func (x byIndex) Less(i, j int) bool {
+	for k, xik := range x[i].index {
+		if k >= len(x[j].index) {
+			return false
+		}
+		if xik != x[j].index[k] {
+			return xik < x[j].index[k]
+		}
+	}
+	return len(x[i].index) < len(x[j].index)
+}
+
+// fieldByIndex follows index through embedded structs.  ok is false if
+// an embedded pointer along the way is nil.
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
+
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package mpack
+
+import (
+	"encoding/binary"
+	"errors"
+	"reflect"
+	"time"
+)
+
+// the timestamp ext type defined by the msgpack spec
+const ext_timestamp int8 = -1
+
+func init() {
+	err := registerExt(ext_timestamp, reflect.TypeOf(time.Time{}), encodeTimestamp, decodeTimestamp)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// use the 32 bit form when there are no nanoseconds and the seconds fit
+// in a uint32, the 64 bit form for seconds up to 2^34, and the 96 bit
+// form for everything else.
+func encodeTimestamp(value interface{}) ([]byte, error) {
+	t := value.(time.Time)
+	sec := t.Unix()
+	nsec := uint64(t.Nanosecond())
+	if sec>>34 == 0 {
+		data64 := nsec<<34 | uint64(sec)
+		if data64&0xffffffff00000000 == 0 {
+			data := make([]byte, 4)
+			binary.BigEndian.PutUint32(data, uint32(data64))
+			return data, nil
+		}
+		data := make([]byte, 8)
+		binary.BigEndian.PutUint64(data, data64)
+		return data, nil
+	}
+	data := make([]byte, 12)
+	binary.BigEndian.PutUint32(data, uint32(nsec))
+	binary.BigEndian.PutUint64(data[4:], uint64(sec))
+	return data, nil
+}
+
+func decodeTimestamp(data []byte) (interface{}, error) {
+	switch len(data) {
+	case 4:
+		return time.Unix(int64(binary.BigEndian.Uint32(data)), 0), nil
+	case 8:
+		data64 := binary.BigEndian.Uint64(data)
+		return time.Unix(int64(data64&0x3ffffffff), int64(data64>>34)), nil
+	case 12:
+		nsec := binary.BigEndian.Uint32(data)
+		sec := int64(binary.BigEndian.Uint64(data[4:]))
+		return time.Unix(sec, int64(nsec)), nil
+	}
+	return nil, errors.New("mpack: invalid timestamp length")
+}
@@ -0,0 +1,329 @@
+package mpack
+
+import (
+	"io"
+	"math"
+)
+
+type TokenKind int
+
+const (
+	NilToken TokenKind = iota
+	BoolToken
+	IntToken
+	UintToken
+	FloatToken
+	StringToken
+	BinToken
+	ArrayToken
+	MapToken
+	ExtToken
+)
+
+var tokenKindNames = []string{"nil", "bool", "int", "uint", "float", "string", "bin", "array", "map", "ext"}
+
+func (k TokenKind) String() string {
+	if k < 0 || int(k) >= len(tokenKindNames) {
+		return "unknown"
+	}
+	return tokenKindNames[k]
+}
+
+// a single item in a packed stream.  Value holds scalars (at the width
+// they had on the wire), strings, bin data and decoded ext values.  Len is
+// the element count of an array, the entry count of a map, and the payload
+// length of a string, bin or ext.  The elements of an array or map are the
+// tokens that follow it.
+type Token struct {
+	Kind    TokenKind
+	Value   interface{}
+	Len     int
+	ExtType int8
+}
+
+// NextToken reads the next token from the stream without reading the
+// elements of arrays and maps, so arbitrarily large streams can be scanned
+// in constant memory.
+func (pr *PackReader) NextToken() (Token, error) {
+	if pr.depth == 0 {
+		pr.consumed = 0
+	}
+	b, err := pr.ReadByte()
+	if err != nil {
+		return Token{}, err
+	}
+	tok, err := pr.readHeader(b)
+	if err != nil {
+		return tok, unexpectedEOF(err)
+	}
+
+	switch tok.Kind {
+	case StringToken:
+		// the string is a copy, so the bytes can always be borrowed
+		data, err := pr.readRaw(tok.Len, true)
+		if err != nil {
+			return tok, unexpectedEOF(err)
+		}
+		if data != nil {
+			tok.Value = string(data)
+		}
+	case BinToken:
+		data, err := pr.readRaw(tok.Len, pr.options.AliasBytes)
+		if err != nil {
+			return tok, unexpectedEOF(err)
+		}
+		if data != nil {
+			tok.Value = data
+		}
+	case ExtToken:
+		data := make([]byte, tok.Len)
+		_, err := io.ReadFull(pr, data)
+		if err != nil {
+			return tok, unexpectedEOF(err)
+		}
+		tok.Value, err = decodeExt(tok.ExtType, data)
+		if err != nil {
+			return tok, err
+		}
+	case IntToken, UintToken, FloatToken:
+		if pr.options.NormalizeNumbers {
+			tok.Value = normalizeNumber(tok.Value)
+		}
+	}
+	return tok, nil
+}
+
+// widen a number to int64, uint64 or float64.  unsigned values that fit
+// are int64 so they compare equal to their signed counterparts.
+func normalizeNumber(generic interface{}) interface{} {
+	switch n := generic.(type) {
+	case int8:
+		return int64(n)
+	case int16:
+		return int64(n)
+	case int32:
+		return int64(n)
+	case uint8:
+		return int64(n)
+	case uint16:
+		return int64(n)
+	case uint32:
+		return int64(n)
+	case uint64:
+		if n <= math.MaxInt64 {
+			return int64(n)
+		}
+	case float32:
+		return float64(n)
+	}
+	return generic
+}
+
+// Skip reads past the next value, including all the elements of an array
+// or map, without decoding it.
+func (pr *PackReader) Skip() error {
+	if pr.depth == 0 {
+		pr.consumed = 0
+	}
+	b, err := pr.ReadByte()
+	if err != nil {
+		return err
+	}
+	return unexpectedEOF(pr.skipValue(b))
+}
+
+func (pr *PackReader) skipValue(b byte) error {
+	tok, err := pr.readHeader(b)
+	if err != nil {
+		return err
+	}
+	count := 0
+	switch tok.Kind {
+	case StringToken, BinToken, ExtToken:
+		_, err := io.CopyN(io.Discard, pr, int64(tok.Len))
+		return err
+	case ArrayToken:
+		count = tok.Len
+	case MapToken:
+		count = 2 * tok.Len
+	default:
+		return nil
+	}
+
+	err = pr.enter()
+	defer pr.leave()
+	if err != nil {
+		return err
+	}
+	for i := 0; i < count; i++ {
+		b, err := pr.ReadByte()
+		if err != nil {
+			return err
+		}
+		err = pr.skipValue(b)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readHeader reads the prefix b and whatever follows it up to the payload.
+// Fixed size values are complete; strings, bin and ext only have their
+// length (and ext type) filled in.
+func (pr *PackReader) readHeader(b byte) (Token, error) {
+	switch {
+	case b <= positive_fix_max:
+		return Token{Kind: UintToken, Value: b}, nil
+	case b >= negative_fix_min:
+		return Token{Kind: IntToken, Value: int8(b)}, nil
+	case b >= type_fix_raw && b <= type_fix_raw_max:
+		return pr.lengthToken(StringToken, uint32(b&fix_raw_count_mask))
+	case b >= type_fix_array_min && b <= type_fix_array_max:
+		return pr.lengthToken(ArrayToken, uint32(b&fix_array_count_mask))
+	case b >= type_fix_map_min && b <= type_fix_map_max:
+		return pr.lengthToken(MapToken, uint32(b&fix_map_count_mask))
+	}
+
+	switch b {
+	case type_nil:
+		return Token{Kind: NilToken}, nil
+	case type_false:
+		return Token{Kind: BoolToken, Value: false}, nil
+	case type_true:
+		return Token{Kind: BoolToken, Value: true}, nil
+	case type_uint8:
+		c, err := pr.ReadByte()
+		return Token{Kind: UintToken, Value: uint8(c)}, err
+	case type_uint16:
+		var result uint16
+		err := pr.ReadBinary(&result)
+		return Token{Kind: UintToken, Value: result}, err
+	case type_uint32:
+		var result uint32
+		err := pr.ReadBinary(&result)
+		return Token{Kind: UintToken, Value: result}, err
+	case type_uint64:
+		var result uint64
+		err := pr.ReadBinary(&result)
+		return Token{Kind: UintToken, Value: result}, err
+	case type_int8:
+		c, err := pr.ReadByte()
+		return Token{Kind: IntToken, Value: int8(c)}, err
+	case type_int16:
+		var result int16
+		err := pr.ReadBinary(&result)
+		return Token{Kind: IntToken, Value: result}, err
+	case type_int32:
+		var result int32
+		err := pr.ReadBinary(&result)
+		return Token{Kind: IntToken, Value: result}, err
+	case type_int64:
+		var result int64
+		err := pr.ReadBinary(&result)
+		return Token{Kind: IntToken, Value: result}, err
+	case type_float:
+		var result float32
+		err := pr.ReadBinary(&result)
+		return Token{Kind: FloatToken, Value: result}, err
+	case type_double:
+		var result float64
+		err := pr.ReadBinary(&result)
+		return Token{Kind: FloatToken, Value: result}, err
+	case type_str8:
+		return pr.readLengthToken(StringToken, 1)
+	case type_raw16:
+		return pr.readLengthToken(StringToken, 2)
+	case type_raw32:
+		return pr.readLengthToken(StringToken, 4)
+	case type_bin8:
+		return pr.readLengthToken(BinToken, 1)
+	case type_bin16:
+		return pr.readLengthToken(BinToken, 2)
+	case type_bin32:
+		return pr.readLengthToken(BinToken, 4)
+	case type_array16:
+		return pr.readLengthToken(ArrayToken, 2)
+	case type_array32:
+		return pr.readLengthToken(ArrayToken, 4)
+	case type_map16:
+		return pr.readLengthToken(MapToken, 2)
+	case type_map32:
+		return pr.readLengthToken(MapToken, 4)
+	case type_fixext1:
+		return pr.extToken(1)
+	case type_fixext2:
+		return pr.extToken(2)
+	case type_fixext4:
+		return pr.extToken(4)
+	case type_fixext8:
+		return pr.extToken(8)
+	case type_fixext16:
+		return pr.extToken(16)
+	case type_ext8:
+		return pr.readExtToken(1)
+	case type_ext16:
+		return pr.readExtToken(2)
+	case type_ext32:
+		return pr.readExtToken(4)
+	}
+
+	return Token{}, &InvalidPrefixError{Prefix: b, Offset: pr.offset - 1}
+}
+
+// read a big endian length of size bytes
+func (pr *PackReader) readLength(size int) (uint32, error) {
+	switch size {
+	case 1:
+		b, err := pr.ReadByte()
+		return uint32(b), err
+	case 2:
+		var length uint16
+		err := pr.ReadBinary(&length)
+		return uint32(length), err
+	}
+	var length uint32
+	err := pr.ReadBinary(&length)
+	return length, err
+}
+
+func (pr *PackReader) readLengthToken(kind TokenKind, size int) (Token, error) {
+	length, err := pr.readLength(size)
+	if err != nil {
+		return Token{Kind: kind}, err
+	}
+	return pr.lengthToken(kind, length)
+}
+
+// check a length against the limits for its kind before anyone allocates
+// anything for it
+func (pr *PackReader) lengthToken(kind TokenKind, length uint32) (Token, error) {
+	var err error
+	switch kind {
+	case ArrayToken:
+		err = pr.checkLength("array length", length, pr.options.MaxArrayLength)
+	case MapToken:
+		err = pr.checkLength("map length", length, pr.options.MaxMapLength)
+	default:
+		err = pr.checkLength("raw length", length, pr.options.MaxRawLength)
+	}
+	return Token{Kind: kind, Len: int(length)}, err
+}
+
+func (pr *PackReader) readExtToken(size int) (Token, error) {
+	length, err := pr.readLength(size)
+	if err != nil {
+		return Token{Kind: ExtToken}, err
+	}
+	return pr.extToken(length)
+}
+
+func (pr *PackReader) extToken(length uint32) (Token, error) {
+	code, err := pr.ReadByte()
+	if err != nil {
+		return Token{Kind: ExtToken}, err
+	}
+	tok, err := pr.lengthToken(ExtToken, length)
+	tok.ExtType = int8(code)
+	return tok, err
+}
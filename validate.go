@@ -0,0 +1,128 @@
+package mpack
+
+import (
+	"errors"
+	"io"
+)
+
+// the Err of a *ValidationError for input that continues after its value
+var ErrTrailingData = errors.New("mpack: data after the end of the value")
+
+// Validate checks that r holds exactly one complete, well formed value,
+// without building it, and that it is within the DefaultDecoderOptions
+// limits.  The first problem is returned as a *ValidationError.
+func Validate(r io.Reader) error {
+	return ValidateOptions(r, DefaultDecoderOptions)
+}
+
+// like Validate, but with the limits in options
+func ValidateOptions(r io.Reader, options DecoderOptions) error {
+	pr := NewPackReaderOptions(r, options)
+	err := pr.validate()
+	if err != nil {
+		if err == io.EOF {
+			return &ValidationError{Offset: 0, Err: io.ErrUnexpectedEOF}
+		}
+		return err
+	}
+	// anything more would be a new value, not counted against MaxBytes
+	pr.consumed = 0
+	_, err = pr.ReadByte()
+	if err == nil {
+		return &ValidationError{Offset: pr.offset - 1, Err: ErrTrailingData}
+	}
+	if err != io.EOF {
+		return &ValidationError{Offset: pr.offset, Err: err}
+	}
+	return nil
+}
+
+// ValidBytes reports whether b is exactly one complete, well formed value
+// within the DefaultDecoderOptions limits.
+func ValidBytes(b []byte) bool {
+	return ValidateOptions(&sliceReader{data: b}, DefaultDecoderOptions) == nil
+}
+
+func (pr *PackReader) invalid(offset int, err error) error {
+	if _, ok := err.(*ValidationError); ok {
+		return err
+	}
+	return &ValidationError{Offset: offset, Err: unexpectedEOF(err)}
+}
+
+// bytes known to be left in the input, or -1 if that isn't known
+func (pr *PackReader) remaining() int {
+	if sr, ok := pr.reader.(*sliceReader); ok {
+		return len(sr.data) - sr.pos
+	}
+	return -1
+}
+
+// read past one value, checking it as it goes
+func (pr *PackReader) validate() error {
+	if pr.depth == 0 {
+		pr.consumed = 0
+	}
+	start := pr.offset
+	b, err := pr.ReadByte()
+	if err != nil {
+		if pr.depth == 0 {
+			return err
+		}
+		return pr.invalid(start, err)
+	}
+	tok, err := pr.readHeader(b)
+	if err != nil {
+		return pr.invalid(start, err)
+	}
+
+	// every element takes at least a byte, so lengths can be checked
+	// against what is left before any of it is read.  a missing element
+	// is reported where it would have started, as it is for a stream.
+	needed := tok.Len
+	if tok.Kind == MapToken {
+		needed *= 2
+	}
+	if left := pr.remaining(); left >= 0 && needed > left {
+		if tok.Kind == ArrayToken || tok.Kind == MapToken {
+			return pr.invalid(pr.offset+left, io.ErrUnexpectedEOF)
+		}
+		return pr.invalid(start, io.ErrUnexpectedEOF)
+	}
+
+	switch tok.Kind {
+	case StringToken, BinToken, ExtToken:
+		if tok.Kind == ExtToken && tok.ExtType < 0 {
+			// the reserved types have to decode the way Unpack would
+			data, err := pr.readRaw(tok.Len, true)
+			if err == nil {
+				_, err = decodeExt(tok.ExtType, data)
+			}
+			if err != nil {
+				return pr.invalid(start, err)
+			}
+			return nil
+		}
+		_, err := io.CopyN(io.Discard, pr, int64(tok.Len))
+		if err != nil {
+			return pr.invalid(start, err)
+		}
+		return nil
+	case ArrayToken, MapToken:
+	default:
+		return nil
+	}
+
+	err = pr.enter()
+	defer pr.leave()
+	if err != nil {
+		return pr.invalid(start, err)
+	}
+	for i := 0; i < needed; i++ {
+		err := pr.validate()
+		if err != nil {
+			return pr.invalid(start, err)
+		}
+	}
+	return nil
+}